@@ -22,6 +22,14 @@ Or using a static token.
         // handle err
     }
 
+Or using a private key to sign client assertions, where the client has been
+configured to authenticate using private key JWT.
+
+    m, err := management.New(domain, management.WithPrivateKeyJWT(id, keyID, key))
+    if err != nil {
+        // handle err
+    }
+
 With a management client we can then interact with the Auth0 Management API.
 
     c := &management.Client{
@@ -60,6 +68,17 @@ new client.
         management.WithContext(context.Background()),
         management.WithDebug(true))
 
+Errors
+
+Errors returned by the Auth0 Management API are of type *management.APIError,
+which holds the status code, error code, response headers and body. The kind of
+error can be checked using errors.Is with one of the sentinel errors.
+
+    u, err := m.User.Read(id)
+    if errors.Is(err, management.ErrNotFound) {
+        // handle missing user
+    }
+
 Request Options
 
 As with the global client configuration, fine grained configuration can be done
@@ -73,5 +92,169 @@ on a request basis.
         management.Parameter("strategy", "auth0"),
     )
 
+Every manager method accepting request options also has a variant taking a
+context as its first argument. The context applies to the whole request,
+including obtaining a token and waiting for the rate limit to reset.
+
+    u, err := m.User.ReadContext(ctx, id)
+
+Unknown Properties
+
+Properties returned by the Auth0 Management API which are not known to this
+package, such as newly released settings, are kept in the Extra field of
+resources like clients, connections and their options, or tenant settings.
+They are sent back unchanged, so that reading, modifying and then updating a
+resource does not reset them.
+
+    c, err := m.Client.Read(id)
+    if err != nil {
+        // handle err
+    }
+    if v, ok := c.Extra["some_new_setting"]; ok {
+        // use v
+    }
+
+Updates
+
+Patch compares two values of a resource and returns one holding only the
+properties which changed, to be sent to the Update method of its manager.
+Read-only properties which did not change, such as identifiers, are then not
+sent.
+
+    p, err := management.Patch(old, new)
+    if err != nil {
+        // handle err
+    }
+    err = m.Client.Update(id, p.(*management.Client))
+
+Read-only Clients
+
+A client configured using WithReadOnly rejects any request modifying resources
+before it is sent. Finer grained protection is available using WithGuardRules,
+with rules checking every such request.
+
+    m, err := management.New(domain,
+        management.WithClientCredentials(id, secret),
+        management.WithReadOnly())
+
+    err = m.Client.Delete(id)
+    if errors.Is(err, management.ErrReadOnly) {
+        // handle rejected request
+    }
+
+Dry Runs
+
+A client configured using WithDryRun records requests modifying resources in
+a plan instead of sending them, which allows reviewing the changes automation
+would make.
+
+    var plan management.Plan
+    m, err := management.New(domain,
+        management.WithClientCredentials(id, secret),
+        management.WithDryRun(&plan))
+
+    // run automation using m
+
+    fmt.Print(plan.String())
+
+Auditing
+
+Requests modifying resources can be recorded using WithAuditSink, for example
+to a file of JSON lines. Sensitive fields of the payloads are redacted, and the
+actor on whose behalf requests are sent is taken from their context.
+
+    sink, err := management.OpenJSONLinesAuditSink("audit.jsonl")
+    if err != nil {
+        // handle err
+    }
+    defer sink.Close()
+
+    m, err := management.New(domain,
+        management.WithClientCredentials(id, secret),
+        management.WithAuditSink(sink))
+
+    ctx = management.ContextWithActor(ctx, "deploy-bot")
+    err = m.Client.UpdateContext(ctx, id, c)
+
+Multiple Tenants
+
+A pool holds the management clients of several tenants, created when first
+used. Each tenant has its own token and rate limit, while the HTTP client is
+shared.
+
+    pool, err := management.NewPool([]management.TenantDefinition{
+        {Name: "eu", Domain: "example.eu.auth0.com", ClientID: id, ClientSecretEnv: "EU_SECRET"},
+        {Name: "us", Domain: "example.us.auth0.com", ClientID: id, ClientSecretEnv: "US_SECRET"},
+    })
+    if err != nil {
+        // handle err
+    }
+
+    results := pool.FanOut(ctx, func(ctx context.Context, tenant string, m *management.Management) (interface{}, error) {
+        return m.Client.ListContext(ctx)
+    })
+
+Exporting Users
+
+DownloadUsers runs a user export job to completion, and decodes the exported
+users while downloading the file.
+
+    e, err := m.Job.DownloadUsersContext(ctx, &management.Job{
+        ConnectionID: auth0.String(id),
+        Format:       auth0.String("json"),
+    })
+    if err != nil {
+        // handle err
+    }
+    defer e.Close()
+    for {
+        u, err := e.Next()
+        if err == management.Done {
+            break
+        }
+        if err != nil {
+            // handle err
+        }
+        // use u
+    }
+
+Importing Users
+
+A user importer imports users read from a file through as many import jobs as
+needed, and reports the users which could not be imported.
+
+    i := &management.UserImporter{Job: m.Job}
+    report, err := i.ImportFrom(ctx, &management.Job{
+        ConnectionID: auth0.String(id),
+    }, file)
+    if err != nil {
+        // handle err
+    }
+    for _, f := range report.Failures {
+        // handle f
+    }
+
+Searching Users
+
+Search queries can be built using the management/search package, which
+escapes the values searched for. SearchAll returns every matching user, even
+when a search would be limited to the first 1000 users.
+
+    q := search.And(
+        search.Phrase("name", name),
+        search.CreatedBetween(from, time.Time{}),
+    )
+    it := m.User.SearchAll(management.Query(q.String()))
+    for {
+        u, err := it.Next()
+        if err == management.Done {
+            break
+        }
+        if err != nil {
+            // handle err
+        }
+        // use u
+    }
+
 */
 package auth0
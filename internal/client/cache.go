@@ -0,0 +1,417 @@
+package client
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenRefreshMargin is how long before their expiry cached tokens are
+// refreshed.
+const tokenRefreshMargin = 5 * time.Minute
+
+// TokenCacheKey identifies a cached token.
+type TokenCacheKey struct {
+	Domain   string
+	ClientID string
+	Audience string
+}
+
+// TokenCache stores tokens so that they can be shared by several token
+// sources, possibly across processes.
+//
+// Get returns a nil token without an error when no token is cached for the
+// key.
+type TokenCache interface {
+	Get(key TokenCacheKey) (*oauth2.Token, error)
+	Put(key TokenCacheKey, token *oauth2.Token) error
+}
+
+// AtomicTokenCache is a TokenCache able to stay locked while a token is
+// requested, so that concurrent users of the cache, possibly in other
+// processes, wait for that token instead of each requesting one.
+type AtomicTokenCache interface {
+	TokenCache
+
+	// Refresh returns the token cached for the key if valid reports it can
+	// be used, or the token returned by fetch otherwise, which is then cached.
+	// The cache is locked meanwhile. Only the errors of fetch are returned.
+	Refresh(key TokenCacheKey, valid func(*oauth2.Token) bool, fetch func() (*oauth2.Token, error)) (*oauth2.Token, error)
+}
+
+// newTokenCacheKey returns the key of tokens issued to the client for the
+// Management API of the tenant at uri.
+func newTokenCacheKey(uri, clientID string) TokenCacheKey {
+	key := TokenCacheKey{ClientID: clientID, Audience: uri + "/api/v2/"}
+	if u, err := url.Parse(uri); err == nil {
+		key.Domain = u.Host
+	}
+	return key
+}
+
+// cachedTokenSource returns tokens from the cache, falling back to src when
+// the cached token is missing or about to expire.
+//
+// Failing to read from or write to the cache does not prevent a token from
+// being returned, as the cache only avoids requesting tokens needlessly.
+type cachedTokenSource struct {
+	src   contextTokenSource
+	cache TokenCache
+	key   TokenCacheKey
+}
+
+func (s *cachedTokenSource) Token() (*oauth2.Token, error) {
+	return s.TokenContext(context.Background())
+}
+
+func (s *cachedTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	valid := func(token *oauth2.Token) bool {
+		return token != nil && refreshable(token).Valid()
+	}
+	fetch := func() (*oauth2.Token, error) {
+		return s.src.TokenContext(ctx)
+	}
+
+	token, err := s.cache.Get(s.key)
+	if err == nil && valid(token) {
+		return refreshable(token), nil
+	}
+	if c, ok := s.cache.(AtomicTokenCache); ok {
+		token, err = c.Refresh(s.key, valid, fetch)
+	} else if token, err = fetch(); err == nil {
+		s.cache.Put(s.key, token)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return refreshable(token), nil
+}
+
+// refreshable returns a copy of the token expiring early enough for it to be
+// refreshed before it is rejected.
+func refreshable(token *oauth2.Token) *oauth2.Token {
+	t := *token
+	if !t.Expiry.IsZero() {
+		t.Expiry = t.Expiry.Add(-tokenRefreshMargin)
+	}
+	return &t
+}
+
+// MemoryTokenCache is a TokenCache keeping tokens in memory, which can be
+// shared by several clients within a process.
+type MemoryTokenCache struct {
+	mu      sync.Mutex
+	tokens  map[TokenCacheKey]*oauth2.Token
+	refresh map[TokenCacheKey]*sync.Mutex
+}
+
+// NewMemoryTokenCache returns an empty MemoryTokenCache.
+func NewMemoryTokenCache() *MemoryTokenCache {
+	return &MemoryTokenCache{
+		tokens:  make(map[TokenCacheKey]*oauth2.Token),
+		refresh: make(map[TokenCacheKey]*sync.Mutex),
+	}
+}
+
+// Get returns the token cached for the key.
+func (c *MemoryTokenCache) Get(key TokenCacheKey) (*oauth2.Token, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tokens[key], nil
+}
+
+// Put caches the token for the key.
+func (c *MemoryTokenCache) Put(key TokenCacheKey, token *oauth2.Token) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[key] = token
+	return nil
+}
+
+// Refresh returns the token cached for the key if valid, or the token
+// returned by fetch otherwise. Concurrent refreshes of a key wait for each
+// other.
+func (c *MemoryTokenCache) Refresh(key TokenCacheKey, valid func(*oauth2.Token) bool, fetch func() (*oauth2.Token, error)) (*oauth2.Token, error) {
+	c.mu.Lock()
+	refresh, ok := c.refresh[key]
+	if !ok {
+		refresh = &sync.Mutex{}
+		c.refresh[key] = refresh
+	}
+	c.mu.Unlock()
+
+	refresh.Lock()
+	defer refresh.Unlock()
+
+	if token, _ := c.Get(key); valid(token) {
+		return token, nil
+	}
+	token, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.Put(key, token)
+	return token, nil
+}
+
+// FileTokenCache is a TokenCache keeping tokens in a file, which can be
+// shared by several processes.
+//
+// The file is encrypted using AES-GCM with a key derived from a secret, and is
+// locked while it is being read or written, as well as while a token missing
+// from the cache is requested.
+type FileTokenCache struct {
+	path   string
+	secret []byte
+}
+
+// fileLockTimeout is how long a lock on the cache file which is no longer
+// refreshed by its owner is considered held.
+var fileLockTimeout = 10 * time.Second
+
+// fileSaltSize is the size of the random salt stored at the start of the
+// cache file, from which the encryption key is derived along with the secret.
+const fileSaltSize = 16
+
+type fileTokenCacheEntry struct {
+	Key   TokenCacheKey `json:"key"`
+	Token *oauth2.Token `json:"token"`
+}
+
+// NewFileTokenCache returns a FileTokenCache storing tokens at path, encrypted
+// with a key derived from the secret using HKDF. The derivation does not make
+// up for a guessable secret, which should be a random value of at least 32
+// bytes.
+func NewFileTokenCache(path string, secret []byte) *FileTokenCache {
+	return &FileTokenCache{path: path, secret: secret}
+}
+
+// aead returns the cipher encrypting the cache file, keyed from the secret
+// and the salt of the file.
+func (c *FileTokenCache) aead(salt []byte) cipher.AEAD {
+	block, _ := aes.NewCipher(hkdf(c.secret, salt, []byte("auth0 token cache"))) // A 32 byte key is always valid.
+	aead, _ := cipher.NewGCM(block)
+	return aead
+}
+
+// hkdf derives a 32 byte key from the secret as defined by RFC 5869, using
+// SHA-256.
+func hkdf(secret, salt, info []byte) []byte {
+	extract := hmac.New(sha256.New, salt)
+	extract.Write(secret)
+	expand := hmac.New(sha256.New, extract.Sum(nil))
+	expand.Write(info)
+	expand.Write([]byte{1})
+	return expand.Sum(nil)
+}
+
+// Get returns the token cached for the key.
+func (c *FileTokenCache) Get(key TokenCacheKey) (*oauth2.Token, error) {
+	unlock, err := c.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	entries, err := c.read()
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.Key == key {
+			return e.Token, nil
+		}
+	}
+	return nil, nil
+}
+
+// Put caches the token for the key. A cache file which can not be decrypted,
+// for example because the secret changed, is overwritten.
+func (c *FileTokenCache) Put(key TokenCacheKey, token *oauth2.Token) error {
+	unlock, err := c.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	entries, _ := c.read()
+	return c.write(c.replace(entries, key, token))
+}
+
+// Refresh returns the token cached for the key if valid, or the token
+// returned by fetch otherwise, keeping the file locked meanwhile. If the file
+// can not be locked, the token is requested without being cached.
+func (c *FileTokenCache) Refresh(key TokenCacheKey, valid func(*oauth2.Token) bool, fetch func() (*oauth2.Token, error)) (*oauth2.Token, error) {
+	unlock, err := c.lock()
+	if err != nil {
+		return fetch()
+	}
+	defer unlock()
+
+	entries, _ := c.read()
+	for _, e := range entries {
+		if e.Key == key && valid(e.Token) {
+			return e.Token, nil
+		}
+	}
+
+	token, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.write(c.replace(entries, key, token))
+	return token, nil
+}
+
+// replace returns the entries with the token of the key replaced.
+func (c *FileTokenCache) replace(entries []fileTokenCacheEntry, key TokenCacheKey, token *oauth2.Token) []fileTokenCacheEntry {
+	for i := range entries {
+		if entries[i].Key == key {
+			entries[i].Token = token
+			return entries
+		}
+	}
+	return append(entries, fileTokenCacheEntry{key, token})
+}
+
+func (c *FileTokenCache) read() ([]fileTokenCacheEntry, error) {
+	b, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < fileSaltSize {
+		return nil, errors.New("token cache: file is corrupted")
+	}
+	aead := c.aead(b[:fileSaltSize])
+	b = b[fileSaltSize:]
+	size := aead.NonceSize()
+	if len(b) < size {
+		return nil, errors.New("token cache: file is corrupted")
+	}
+	b, err = aead.Open(nil, b[:size], b[size:], nil)
+	if err != nil {
+		return nil, errors.New("token cache: unable to decrypt file")
+	}
+	var entries []fileTokenCacheEntry
+	err = json.Unmarshal(b, &entries)
+	return entries, err
+}
+
+// write replaces the cache file atomically, so that it is never observed
+// partially written.
+func (c *FileTokenCache) write(entries []fileTokenCacheEntry) error {
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	salt := make([]byte, fileSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	aead := c.aead(salt)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	b = append(salt, aead.Seal(nonce, nonce, b, nil)...)
+
+	f, err := ioutil.TempFile(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), c.path)
+}
+
+// lock acquires an exclusive lock on the cache file by creating a lock file
+// next to it, returning a function releasing the lock.
+//
+// The lock file holds a token identifying its owner, so that a lock taken over
+// by another process is never released by the previous owner, and its
+// modification time is refreshed while the lock is held. A lock which has not
+// been refreshed for fileLockTimeout was left behind by a process which died,
+// otherwise lock waits for it to be released.
+func (c *FileTokenCache) lock() (func(), error) {
+	path := c.path + ".lock"
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	owner := hex.EncodeToString(b)
+	owned := func() bool {
+		b, err := ioutil.ReadFile(path)
+		return err == nil && string(b) == owner
+	}
+
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_, err = f.WriteString(owner)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				os.Remove(path)
+				return nil, err
+			}
+			break
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+		if fi, err := os.Stat(path); err == nil && time.Since(fi.ModTime()) > fileLockTimeout {
+			os.Remove(path) // The lock was left behind by a process which died.
+			continue
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(fileLockTimeout / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				if owned() {
+					os.Chtimes(path, now, now)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+		if owned() {
+			os.Remove(path)
+		}
+	}, nil
+}
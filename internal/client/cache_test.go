@@ -0,0 +1,246 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestTokenCache(t *testing.T) {
+
+	var requests int
+	expiresIn := 3600
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, requests, expiresIn)
+	})
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for name, cache := range map[string]func() TokenCache{
+		"Memory": func() TokenCache { return NewMemoryTokenCache() },
+		"File":   func() TokenCache { return NewFileTokenCache(filepath.Join(dir, "tokens"), []byte("secret")) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			requests = 0
+			c := cache()
+
+			for i := 0; i < 2; i++ {
+				token, err := ClientCredentials(context.Background(), s.URL, "client-id", "secret", c).Token()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if token.AccessToken != "token-1" {
+					t.Errorf("Expected access token to be %q but got %q", "token-1", token.AccessToken)
+				}
+			}
+			if requests != 1 {
+				t.Errorf("Expected the cached token to be reused, but got %d token requests", requests)
+			}
+
+			if _, err := ClientCredentials(context.Background(), s.URL, "other-client-id", "secret", c).Token(); err != nil {
+				t.Fatal(err)
+			}
+			if requests != 2 {
+				t.Errorf("Expected a token to be cached per client, but got %d token requests", requests)
+			}
+		})
+	}
+
+	t.Run("Refresh", func(t *testing.T) {
+		requests = 0
+		expiresIn = 60
+		defer func() { expiresIn = 3600 }()
+
+		c := NewMemoryTokenCache()
+		for i := 0; i < 2; i++ {
+			if _, err := ClientCredentials(context.Background(), s.URL, "client-id", "secret", c).Token(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if requests != 2 {
+			t.Errorf("Expected a token about to expire to be refreshed, but got %d token requests", requests)
+		}
+	})
+}
+
+func TestFileTokenCache(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "tokens")
+	key := TokenCacheKey{"example.auth0.com", "client-id", "https://example.auth0.com/api/v2/"}
+	token := &oauth2.Token{AccessToken: "access-token", Expiry: time.Now().Add(time.Hour)}
+
+	c := NewFileTokenCache(path, []byte("secret"))
+	if err := c.Put(key, token); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b, []byte("access-token")) {
+		t.Error("Expected the cache file to be encrypted")
+	}
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Error("Expected the cache file lock to be released")
+	}
+
+	cached, err := NewFileTokenCache(path, []byte("secret")).Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached.AccessToken != token.AccessToken {
+		t.Errorf("Expected access token to be %q but got %q", token.AccessToken, cached.AccessToken)
+	}
+
+	if _, err := NewFileTokenCache(path, []byte("other")).Get(key); err == nil {
+		t.Error("Expected reading the cache with another secret to fail")
+	}
+
+	missing, err := c.Get(TokenCacheKey{Domain: "other.auth0.com"})
+	if err != nil || missing != nil {
+		t.Errorf("Expected no token for a missing key, got %v, %v", missing, err)
+	}
+}
+
+func TestFileTokenCacheLock(t *testing.T) {
+
+	defer func(timeout time.Duration) { fileLockTimeout = timeout }(fileLockTimeout)
+	fileLockTimeout = 200 * time.Millisecond
+
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "tokens")
+	key := TokenCacheKey{"example.auth0.com", "client-id", "https://example.auth0.com/api/v2/"}
+
+	t.Run("SlowFetch", func(t *testing.T) {
+		valid := func(token *oauth2.Token) bool { return token != nil }
+		var fetches int32
+		fetch := func() (*oauth2.Token, error) {
+			atomic.AddInt32(&fetches, 1)
+			time.Sleep(3 * fileLockTimeout)
+			return &oauth2.Token{AccessToken: "access-token", Expiry: time.Now().Add(time.Hour)}, nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				token, err := NewFileTokenCache(path, []byte("secret")).Refresh(key, valid, fetch)
+				if err != nil {
+					t.Error(err)
+				} else if token.AccessToken != "access-token" {
+					t.Errorf("Expected access token to be %q but got %q", "access-token", token.AccessToken)
+				}
+			}()
+			time.Sleep(fileLockTimeout / 4)
+		}
+		wg.Wait()
+
+		if fetches != 1 {
+			t.Errorf("Expected the token to be requested once while the lock is held, got %d requests", fetches)
+		}
+	})
+
+	t.Run("Unlock", func(t *testing.T) {
+		unlock, err := NewFileTokenCache(path, []byte("secret")).lock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path+".lock", []byte("other"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		unlock()
+
+		b, err := ioutil.ReadFile(path + ".lock")
+		if err != nil || string(b) != "other" {
+			t.Errorf("Expected a lock held by another owner to be kept, got %q, %v", b, err)
+		}
+	})
+}
+
+func TestTokenCacheConcurrent(t *testing.T) {
+
+	var requests int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, n)
+	})
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	memory := NewMemoryTokenCache()
+	for name, cache := range map[string]func() TokenCache{
+		"Memory": func() TokenCache { return memory },
+		// Each user has its own instance, like separate processes would.
+		"File": func() TokenCache { return NewFileTokenCache(filepath.Join(dir, "tokens"), []byte("secret")) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			atomic.StoreInt32(&requests, 0)
+
+			var wg sync.WaitGroup
+			tokens := make([]string, 2)
+			for i := range tokens {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					token, err := ClientCredentials(context.Background(), s.URL, "client-id", "secret", cache()).Token()
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					tokens[i] = token.AccessToken
+				}(i)
+			}
+			wg.Wait()
+
+			if n := atomic.LoadInt32(&requests); n != 1 {
+				t.Errorf("Expected exactly 1 token request, got %d", n)
+			}
+			if tokens[0] != "token-1" || tokens[1] != "token-1" {
+				t.Errorf("Expected both users to get token-1, got %q", tokens)
+			}
+		})
+	}
+}
@@ -3,11 +3,11 @@ package client
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/PuerkitoBio/rehttp"
@@ -57,47 +57,351 @@ func delay(attempt rehttp.Attempt) time.Duration {
 	return time.Duration(resetAtUnix-time.Now().Unix()) * time.Second
 }
 
-// RateLimitTransport wraps base transport with a customized "User-Agent" header
-func UserAgentTransport(base http.RoundTripper, userAgent string) http.RoundTripper {
+// RetryPolicy configures which failed requests are retried by RetryTransport,
+// and how long it waits before doing so. The zero value of any field selects
+// its default.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	MaxWait     time.Duration
+	Statuses    []int
+	Methods     []string
+}
+
+// DefaultRetryPolicy holds the values used for unset RetryPolicy fields.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+	Statuses: []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+	Methods: []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPut,
+		http.MethodPatch,
+		http.MethodDelete,
+	},
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = DefaultRetryPolicy.BaseDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultRetryPolicy.MaxDelay
+	}
+	if p.Statuses == nil {
+		p.Statuses = DefaultRetryPolicy.Statuses
+	}
+	if p.Methods == nil {
+		p.Methods = DefaultRetryPolicy.Methods
+	}
+	return p
+}
+
+// retryable determines whether the outcome of an attempt can be retried.
+//
+// Requests rejected with a 429 status code were not processed by the server,
+// so they are retried regardless of their method. Any other status code or
+// network error is only retried for the configured methods.
+func (p RetryPolicy) retryable(attempt rehttp.Attempt) bool {
+	if attempt.Request.Context().Err() != nil {
+		return false
+	}
+	if attempt.Response != nil {
+		status := attempt.Response.StatusCode
+		if !containsInt(p.Statuses, status) {
+			return false
+		}
+		return status == http.StatusTooManyRequests || containsString(p.Methods, attempt.Request.Method)
+	}
+	return attempt.Error != nil && containsString(p.Methods, attempt.Request.Method)
+}
+
+// delay returns how long to wait before retrying an attempt. Rate limited
+// attempts wait until the time indicated by the "X-RateLimit-Reset" header,
+// and responses with a "Retry-After" header for as long as it indicates,
+// while anything else uses exponential backoff with jitter.
+func (p RetryPolicy) delay(attempt rehttp.Attempt) time.Duration {
+	if attempt.Response != nil {
+		h := attempt.Response.Header
+		if attempt.Response.StatusCode == http.StatusTooManyRequests {
+			if resetAtUnix, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+				return nonNegative(time.Until(time.Unix(resetAtUnix, 0)))
+			}
+		}
+		if retryAfter := h.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil {
+				return nonNegative(time.Duration(seconds) * time.Second)
+			}
+			if t, err := http.ParseTime(retryAfter); err == nil {
+				return nonNegative(time.Until(t))
+			}
+		}
+	}
+	return rehttp.ExpJitterDelay(p.BaseDelay, p.MaxDelay)(attempt)
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// RetryTransport wraps base transport with the ability to retry requests
+// failing with a transient error, as configured by the retry policy.
+//
+// Requests are attempted at most MaxAttempts times. A request is not retried
+// if doing so would exceed a total wait of MaxWait, when that is set.
+func RetryTransport(base http.RoundTripper, p RetryPolicy) http.RoundTripper {
 	if base == nil {
 		base = http.DefaultTransport
 	}
+	p = p.withDefaults()
 	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
-		req.Header.Set("User-Agent", userAgent)
-		return base.RoundTrip(req)
+		var waited, next time.Duration
+		retry := func(attempt rehttp.Attempt) bool {
+			if attempt.Index+1 >= p.MaxAttempts || !p.retryable(attempt) {
+				return false
+			}
+			next = p.delay(attempt)
+			if p.MaxWait > 0 && waited+next > p.MaxWait {
+				return false
+			}
+			waited += next
+			return true
+		}
+		delay := func(rehttp.Attempt) time.Duration {
+			return next
+		}
+		return rehttp.NewTransport(base, retry, delay).RoundTrip(req)
 	})
 }
 
-func dumpRequest(r *http.Request) {
-	b, _ := httputil.DumpRequestOut(r, true)
-	log.Printf("\n%s\n", b)
+func containsInt(l []int, v int) bool {
+	for _, i := range l {
+		if i == v {
+			return true
+		}
+	}
+	return false
 }
 
-func dumpResponse(r *http.Response) {
-	b, _ := httputil.DumpResponse(r, true)
-	log.Printf("\n%s\n\n", b)
+func containsString(l []string, v string) bool {
+	for _, s := range l {
+		if s == v {
+			return true
+		}
+	}
+	return false
 }
 
-// RateLimitTransport wraps base transport with the ability to log the contents
-// of requests and responses.
-func DebugTransport(base http.RoundTripper, debug bool) http.RoundTripper {
+// Budget is the rate limit budget last reported by the server through the
+// "X-RateLimit-*" response headers.
+type Budget struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// RateLimiter paces outgoing requests using a token bucket sized after the
+// rate limit budget reported by the server. The bucket holds the remaining
+// budget and refills at a steady rate, so that it is full by the time the
+// limit resets.
+//
+// A RateLimiter is safe for concurrent use, and does not pace requests until
+// the server has reported a budget.
+type RateLimiter struct {
+	mu      sync.Mutex
+	budget  Budget
+	tokens  float64
+	rate    float64
+	updated time.Time
+}
+
+// NewRateLimiter returns a RateLimiter with an unknown budget.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{}
+}
+
+// Budget returns the rate limit budget last reported by the server.
+func (l *RateLimiter) Budget() Budget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.budget
+}
+
+// ParseBudget parses the budget from the "X-RateLimit-Limit",
+// "X-RateLimit-Remaining" and "X-RateLimit-Reset" headers, reporting whether
+// they were all present and well formed.
+func ParseBudget(h http.Header) (Budget, bool) {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return Budget{}, false
+	}
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return Budget{}, false
+	}
+	reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return Budget{}, false
+	}
+	return Budget{limit, remaining, time.Unix(reset, 0)}, true
+}
+
+// Update refreshes the budget from the response headers. Headers that are
+// missing or malformed are ignored.
+func (l *RateLimiter) Update(h http.Header) {
+	budget, ok := ParseBudget(h)
+	if !ok {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.budget = budget
+	l.tokens = float64(budget.Remaining)
+	l.rate = 0
+	if d := budget.Reset.Sub(now); d > 0 {
+		l.rate = float64(budget.Limit-budget.Remaining) / d.Seconds()
+	}
+	l.updated = now
+}
+
+// reserve takes a token from the bucket and returns how long to wait before
+// using it.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.budget.Limit == 0 || !now.Before(l.budget.Reset) {
+		return 0 // The budget is either unknown or has expired.
+	}
+
+	l.tokens += l.rate * now.Sub(l.updated).Seconds()
+	if limit := float64(l.budget.Limit); l.tokens > limit {
+		l.tokens = limit
+	}
+	l.updated = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	if l.rate <= 0 {
+		return l.budget.Reset.Sub(now)
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// release returns a reserved token to the bucket.
+func (l *RateLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+}
+
+// Wait blocks until a request can be sent without exceeding the budget, or
+// until ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	d := l.reserve(time.Now())
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		l.release()
+		return ctx.Err()
+	}
+}
+
+// RateLimiterTransport wraps base transport with the ability to pace requests
+// according to the budget tracked by the rate limiter.
+func RateLimiterTransport(base http.RoundTripper, l *RateLimiter) http.RoundTripper {
 	if base == nil {
 		base = http.DefaultTransport
 	}
-	if !debug {
-		return base
-	}
 	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
-		dumpRequest(req)
+		if err := l.Wait(req.Context()); err != nil {
+			return nil, err
+		}
 		res, err := base.RoundTrip(req)
-		if err != nil {
-			return res, err
+		if res != nil {
+			l.Update(res.Header)
 		}
-		dumpResponse(res)
-		return res, nil
+		return res, err
+	})
+}
+
+type attemptsKey struct{}
+
+// CountAttempts returns a context under which the attempts made by
+// AttemptsTransport to send a request are counted, along with a function
+// returning the count.
+func CountAttempts(ctx context.Context) (context.Context, func() int) {
+	var attempts int32
+	return context.WithValue(ctx, attemptsKey{}, &attempts), func() int {
+		return int(atomic.LoadInt32(&attempts))
+	}
+}
+
+// AttemptsTransport wraps base transport with the ability to count the
+// attempts made to send requests, including retries, whose context was
+// returned by CountAttempts.
+func AttemptsTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if attempts, ok := req.Context().Value(attemptsKey{}).(*int32); ok {
+			atomic.AddInt32(attempts, 1)
+		}
+		return base.RoundTrip(req)
+	})
+}
+
+// RateLimitTransport wraps base transport with a customized "User-Agent" header
+func UserAgentTransport(base http.RoundTripper, userAgent string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		req.Header.Set("User-Agent", userAgent)
+		return base.RoundTrip(req)
 	})
 }
 
+// RateLimitTransport wraps base transport with the ability to log the contents
+// of requests and responses, redacting sensitive headers and fields.
+func DebugTransport(base http.RoundTripper, debug bool) http.RoundTripper {
+	if !debug {
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		return base
+	}
+	return Debugger{}.Transport(base)
+}
+
 // Option is the type used to configure a client.
 type Option func(*http.Client)
 
@@ -108,6 +412,14 @@ func WithDebug(debug bool) Option {
 	}
 }
 
+// WithDebugger configures the client to dump requests and responses using the
+// debugger.
+func WithDebugger(d Debugger) Option {
+	return func(c *http.Client) {
+		c.Transport = d.Transport(c.Transport)
+	}
+}
+
 // WithRateLimit configures the client to enable rate limiting.
 func WithRateLimit() Option {
 	return func(c *http.Client) {
@@ -115,6 +427,30 @@ func WithRateLimit() Option {
 	}
 }
 
+// WithAttempts configures the client to count the attempts made to send
+// requests, as reported through CountAttempts.
+func WithAttempts() Option {
+	return func(c *http.Client) {
+		c.Transport = AttemptsTransport(c.Transport)
+	}
+}
+
+// WithRateLimiter configures the client to pace requests using the rate
+// limiter.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(c *http.Client) {
+		c.Transport = RateLimiterTransport(c.Transport, l)
+	}
+}
+
+// WithRetries configures the client to retry failed requests according to the
+// retry policy.
+func WithRetries(p RetryPolicy) Option {
+	return func(c *http.Client) {
+		c.Transport = RetryTransport(c.Transport, p)
+	}
+}
+
 // WithUserAgent configures the client to overwrite the user agent header.
 func WithUserAgent(userAgent string) Option {
 	return func(c *http.Client) {
@@ -128,11 +464,8 @@ func Wrap(base *http.Client, tokenSource oauth2.TokenSource, options ...Option)
 		base = http.DefaultClient
 	}
 	client := &http.Client{
-		Timeout: base.Timeout,
-		Transport: &oauth2.Transport{
-			Base:   base.Transport,
-			Source: tokenSource,
-		},
+		Timeout:   base.Timeout,
+		Transport: TokenTransport(base.Transport, tokenSource),
 	}
 	for _, option := range options {
 		option(client)
@@ -140,15 +473,21 @@ func Wrap(base *http.Client, tokenSource oauth2.TokenSource, options ...Option)
 	return client
 }
 
-func ClientCredentials(ctx context.Context, uri, clientID, clientSecret string) oauth2.TokenSource {
-	return (&clientcredentials.Config{
+// ClientCredentials returns a token source authenticating with the client
+// credentials flow. Tokens are shared through the cache, unless it is nil.
+func ClientCredentials(ctx context.Context, uri, clientID, clientSecret string, cache TokenCache) oauth2.TokenSource {
+	config := &clientcredentials.Config{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
 		TokenURL:     uri + "/oauth/token",
 		EndpointParams: url.Values{
 			"audience": {uri + "/api/v2/"},
 		},
-	}).TokenSource(ctx)
+	}
+	src := tokenSourceFunc(func(reqCtx context.Context) (*oauth2.Token, error) {
+		return config.Token(tokenContext(reqCtx, ctx))
+	})
+	return newReuseTokenSource(src, cache, newTokenCacheKey(uri, clientID))
 }
 
 func StaticToken(token string) oauth2.TokenSource {
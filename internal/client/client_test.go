@@ -1,11 +1,14 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
+
+	"golang.org/x/oauth2"
 )
 
 func TestWrapRateLimit(t *testing.T) {
@@ -60,3 +63,203 @@ func TestWrapUserAgent(t *testing.T) {
 	c := Wrap(s.Client(), StaticToken(""), WithUserAgent(UserAgent))
 	c.Get(s.URL)
 }
+
+func TestWrapRetries(t *testing.T) {
+
+	var attempts int
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	policy := RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+	t.Run("Idempotent", func(t *testing.T) {
+		attempts = 0
+		c := Wrap(s.Client(), StaticToken(""), WithRetries(policy))
+		r, err := c.Get(s.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.StatusCode != http.StatusOK {
+			t.Errorf("Expected status code to be %d but got %d", http.StatusOK, r.StatusCode)
+		}
+		if attempts != 3 {
+			t.Errorf("Expected 3 attempts but got %d", attempts)
+		}
+	})
+
+	t.Run("NonIdempotent", func(t *testing.T) {
+		attempts = 0
+		c := Wrap(s.Client(), StaticToken(""), WithRetries(policy))
+		r, err := c.Post(s.URL, "application/json", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("Expected status code to be %d but got %d", http.StatusServiceUnavailable, r.StatusCode)
+		}
+		if attempts != 1 {
+			t.Errorf("Expected 1 attempt but got %d", attempts)
+		}
+	})
+
+	t.Run("MaxAttempts", func(t *testing.T) {
+		attempts = 0
+		p := policy
+		p.MaxAttempts = 2
+		c := Wrap(s.Client(), StaticToken(""), WithRetries(p))
+		r, err := c.Get(s.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("Expected status code to be %d but got %d", http.StatusServiceUnavailable, r.StatusCode)
+		}
+		if attempts != 2 {
+			t.Errorf("Expected 2 attempts but got %d", attempts)
+		}
+	})
+
+	t.Run("MaxWait", func(t *testing.T) {
+		attempts = 0
+		p := policy
+		p.BaseDelay = time.Second
+		p.MaxDelay = time.Second
+		p.MaxWait = time.Nanosecond
+		c := Wrap(s.Client(), StaticToken(""), WithRetries(p))
+		start := time.Now()
+		if _, err := c.Get(s.URL); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed >= time.Second {
+			t.Errorf("Expected retries to stop before waiting, but took %s", elapsed)
+		}
+	})
+}
+
+func TestWrapRetriesRateLimit(t *testing.T) {
+
+	start := time.Now()
+	first := true
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if first {
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprint(start.Add(time.Second).Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+			first = !first
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	c := Wrap(s.Client(), StaticToken(""), WithRetries(RetryPolicy{}))
+	r, err := c.Post(s.URL, "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StatusCode != http.StatusOK {
+		t.Errorf("Expected status code to be %d but got %d", http.StatusOK, r.StatusCode)
+	}
+}
+
+func TestWrapRateLimiter(t *testing.T) {
+
+	reset := time.Now().Add(2 * time.Second).Unix()
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", fmt.Sprint(reset))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	l := NewRateLimiter()
+	c := Wrap(s.Client(), StaticToken(""), WithRateLimiter(l))
+
+	start := time.Now()
+	if _, err := c.Get(s.URL); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected the first request not to be paced, but took %s", elapsed)
+	}
+
+	b := l.Budget()
+	if b.Limit != 10 || b.Remaining != 0 || b.Reset.Unix() != reset {
+		t.Errorf("Unexpected budget %+v", b)
+	}
+
+	start = time.Now()
+	if _, err := c.Get(s.URL); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected the second request to be paced, but took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequest(http.MethodGet, s.URL, nil)
+	if _, err := c.Do(req.WithContext(ctx)); err == nil {
+		t.Error("Expected a paced request to fail once its context is done")
+	}
+}
+
+func TestWrapContext(t *testing.T) {
+
+	release := make(chan struct{})
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			<-release
+		default:
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	})
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+	defer close(release) // Unblock token requests before closing the server.
+
+	for name, ts := range map[string]func() oauth2.TokenSource{
+		"TokenRefresh": func() oauth2.TokenSource {
+			return ClientCredentials(context.Background(), s.URL, "client-id", "secret", nil)
+		},
+		"RateLimit": func() oauth2.TokenSource {
+			return StaticToken("")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := Wrap(s.Client(), ts(), WithRateLimit())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			req, _ := http.NewRequest(http.MethodGet, s.URL+"/api/v2/users", nil)
+			start := time.Now()
+			if _, err := c.Do(req.WithContext(ctx)); err == nil {
+				t.Error("Expected the request to fail once its context is done")
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("Expected the request to be canceled along with its context, but took %s", elapsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces the values of sensitive headers and fields.
+const redacted = "[REDACTED]"
+
+// unparseable replaces the payloads which can not be redacted.
+const unparseable = "[unparseable body omitted]"
+
+// redactedHeaders are the headers whose values are always redacted.
+var redactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// RedactedFields are the JSON and form fields whose values are redacted by
+// default. Field names are compared ignoring case, underscores and dashes, so
+// that "api_key" also matches "apiKey".
+var RedactedFields = []string{
+	"access_token",
+	"access_key_id",
+	"api_key",
+	"auth_token",
+	"client_assertion",
+	"client_secret",
+	"datadog_api_key",
+	"http_authorization",
+	"id_token",
+	"password",
+	"private_key",
+	"refresh_token",
+	"secret",
+	"secret_access_key",
+	"smtp_pass",
+	"splunk_token",
+	"sumo_source_address",
+	"token",
+}
+
+// redactedPaths matches the paths of requests whose JSON payloads are made of
+// secrets only, such as hook secrets and rule configs.
+var redactedPaths = regexp.MustCompile(`/hooks/[^/]+/secrets$|/rules-configs/`)
+
+// Debugger dumps requests and responses, redacting sensitive headers and
+// fields.
+type Debugger struct {
+	// Printf writes the dumps. Defaults to log.Printf.
+	Printf func(format string, v ...interface{})
+
+	// Fields are the JSON and form fields to redact in addition to
+	// RedactedFields.
+	Fields []string
+}
+
+// Transport wraps base transport with the ability to dump the contents of
+// requests and responses.
+func (d Debugger) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if d.Printf == nil {
+		d.Printf = log.Printf
+	}
+	fields := redactedFieldSet(d.Fields)
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		d.Printf("\n%s\n", dumpRequest(req, fields))
+		res, err := base.RoundTrip(req)
+		if err != nil {
+			return res, err
+		}
+		d.Printf("\n%s\n\n", dumpResponse(res, req.URL.Path, fields))
+		return res, nil
+	})
+}
+
+func dumpRequest(r *http.Request, fields map[string]bool) []byte {
+	body := readBody(&r.Body)
+
+	c := *r
+	c.Header = redactHeader(r.Header)
+	c.Body = nil
+	b, _ := httputil.DumpRequestOut(&c, false)
+
+	return append(b, redactBody(body, r.Header.Get("Content-Type"), r.URL.Path, fields)...)
+}
+
+func dumpResponse(r *http.Response, path string, fields map[string]bool) []byte {
+	body := readBody(&r.Body)
+
+	c := *r
+	c.Header = redactHeader(r.Header)
+	c.Body = nil
+	b, _ := httputil.DumpResponse(&c, false)
+
+	return append(b, redactBody(body, r.Header.Get("Content-Type"), path, fields)...)
+}
+
+// readBody reads the body, replacing it so that it can be read again.
+func readBody(body *io.ReadCloser) []byte {
+	if *body == nil || *body == http.NoBody {
+		return nil
+	}
+	b, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func redactHeader(h http.Header) http.Header {
+	c := make(http.Header, len(h))
+	for k, v := range h {
+		c[k] = v
+	}
+	for _, k := range redactedHeaders {
+		if _, ok := c[k]; ok {
+			c.Set(k, redacted)
+		}
+	}
+	return c
+}
+
+// Redact returns the request or response body sent to path, with the values
+// of RedactedFields and of the extra fields redacted.
+func Redact(body []byte, contentType, path string, fields ...string) []byte {
+	return redactBody(body, contentType, path, redactedFieldSet(fields))
+}
+
+// redactBody redacts the sensitive fields of JSON and form payloads. Multipart
+// payloads, such as user imports, and payloads which can not be parsed, and
+// therefore redacted, are omitted altogether.
+func redactBody(b []byte, contentType, path string, fields map[string]bool) []byte {
+	if len(b) == 0 {
+		return b
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		return []byte("[multipart body omitted]")
+
+	case mediaType == "application/x-www-form-urlencoded":
+		v, err := url.ParseQuery(string(b))
+		if err != nil {
+			return []byte(unparseable)
+		}
+		for k := range v {
+			if fields[normalizeField(k)] {
+				v.Set(k, redacted)
+			}
+		}
+		return []byte(v.Encode())
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil || d.More() {
+		return []byte(unparseable)
+	}
+	v = redactValue(v, fields, redactedPaths.MatchString(path))
+	if r, err := json.Marshal(v); err == nil {
+		return r
+	}
+	return []byte(unparseable)
+}
+
+// redactValue redacts the values of sensitive fields, or of every field when
+// all is true.
+func redactValue(v interface{}, fields map[string]bool, all bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if all || fields[normalizeField(k)] {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(e, fields, all)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(e, fields, all)
+		}
+	}
+	return v
+}
+
+// redactedFieldSet returns the normalized set of RedactedFields and the extra
+// fields.
+func redactedFieldSet(extra []string) map[string]bool {
+	fields := make(map[string]bool)
+	for _, l := range [][]string{RedactedFields, extra} {
+		for _, f := range l {
+			fields[normalizeField(f)] = true
+		}
+	}
+	return fields
+}
+
+func normalizeField(f string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(f))
+}
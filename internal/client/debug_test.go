@@ -0,0 +1,118 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDebugger(t *testing.T) {
+
+	var received string
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"response-token","token_type":"Bearer"}`))
+	})
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	var out bytes.Buffer
+	d := Debugger{
+		Printf: func(format string, v ...interface{}) { fmt.Fprintf(&out, format, v...) },
+		Fields: []string{"favorite_color"},
+	}
+	c := Wrap(s.Client(), StaticToken("bearer-token"), WithDebugger(d))
+
+	for _, test := range []struct {
+		name        string
+		path        string
+		contentType string
+		body        string
+		visible     []string
+	}{
+		{
+			name:        "JSON",
+			path:        "/api/v2/users",
+			contentType: "application/json",
+			body:        `{"email":"alice@example.com","password":"user-password","user_metadata":{"favoriteColor":"user-color"}}`,
+			visible:     []string{"alice@example.com"},
+		},
+		{
+			name:        "Form",
+			path:        "/oauth/token",
+			contentType: "application/x-www-form-urlencoded",
+			body:        url.Values{"client_id": {"client-id"}, "client_secret": {"client-secret"}}.Encode(),
+			visible:     []string{"client-id"},
+		},
+		{
+			name:        "MalformedForm",
+			path:        "/oauth/token",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "client_id=client-id&client_secret=client-secret&audience=%zz",
+			visible:     []string{"[unparseable body omitted]"},
+		},
+		{
+			name:        "MalformedJSON",
+			path:        "/api/v2/users",
+			contentType: "application/json",
+			body:        `{"email":"alice@example.com","password":"user-password"`,
+			visible:     []string{"[unparseable body omitted]"},
+		},
+		{
+			name:        "Secrets",
+			path:        "/api/v2/hooks/123/secrets",
+			contentType: "application/json",
+			body:        `{"API_TOKEN":"hook-secret"}`,
+			visible:     []string{"API_TOKEN"},
+		},
+		{
+			name:        "Multipart",
+			path:        "/api/v2/jobs/users-imports",
+			contentType: "multipart/form-data; boundary=x",
+			body:        "--x\r\nContent-Disposition: form-data; name=\"users\"\r\n\r\nimport-password\r\n--x--\r\n",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			out.Reset()
+
+			res, err := c.Post(s.URL+test.path, test.contentType, strings.NewReader(test.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if received != test.body {
+				t.Errorf("Expected the request body to be sent unchanged, got %q", received)
+			}
+
+			dump := out.String()
+			for _, secret := range []string{
+				"bearer-token",
+				"response-token",
+				"user-password",
+				"user-color",
+				"client-secret",
+				"hook-secret",
+				"import-password",
+			} {
+				if strings.Contains(dump, secret) {
+					t.Errorf("Expected %q to be redacted from:\n%s", secret, dump)
+				}
+			}
+			for _, v := range test.visible {
+				if !strings.Contains(dump, v) {
+					t.Errorf("Expected %q not to be redacted from:\n%s", v, dump)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha256" // Registers the hash functions used by crypto.Hash.
+	_ "crypto/sha512"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// clientAssertionType is the assertion type of a JWT used for client
+// authentication, as defined by RFC 7523.
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// clientAssertionLifetime is how long a client assertion remains valid.
+const clientAssertionLifetime = time.Minute
+
+// PrivateKeyJWT returns a token source authenticating with the client
+// credentials flow, where the token request is authenticated using a client
+// assertion signed with key instead of a client secret.
+//
+// RSA keys sign assertions using RS256, while EC keys use ES256, ES384 or
+// ES512 depending on their curve. A new assertion is signed every time the
+// token expires. Tokens are shared through the cache, unless it is nil.
+func PrivateKeyJWT(ctx context.Context, uri, clientID, keyID string, key crypto.Signer, cache TokenCache) oauth2.TokenSource {
+	src := &privateKeyJWTSource{
+		ctx:      ctx,
+		uri:      uri,
+		clientID: clientID,
+		keyID:    keyID,
+		key:      key,
+	}
+	return newReuseTokenSource(src, cache, newTokenCacheKey(uri, clientID))
+}
+
+type privateKeyJWTSource struct {
+	ctx      context.Context
+	uri      string
+	clientID string
+	keyID    string
+	key      crypto.Signer
+}
+
+func (s *privateKeyJWTSource) Token() (*oauth2.Token, error) {
+	return s.TokenContext(context.Background())
+}
+
+// TokenContext requests a new token, authenticated by a freshly signed
+// assertion.
+func (s *privateKeyJWTSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	assertion, err := s.assertion(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return (&clientcredentials.Config{
+		ClientID:  s.clientID,
+		TokenURL:  s.uri + "/oauth/token",
+		AuthStyle: oauth2.AuthStyleInParams,
+		EndpointParams: url.Values{
+			"audience":              {s.uri + "/api/v2/"},
+			"client_assertion_type": {clientAssertionType},
+			"client_assertion":      {assertion},
+		},
+	}).Token(tokenContext(ctx, s.ctx))
+}
+
+// assertion builds and signs a client assertion JWT.
+func (s *privateKeyJWTSource) assertion(now time.Time) (string, error) {
+	alg, err := signingAlgorithm(s.key)
+	if err != nil {
+		return "", err
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{
+		"alg": alg.name,
+		"typ": "JWT",
+		"kid": s.keyID,
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss": s.clientID,
+		"sub": s.clientID,
+		"aud": s.uri + "/",
+		"iat": now.Unix(),
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+		"jti": hex.EncodeToString(jti),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+
+	signature, err := alg.sign(s.key, unsigned)
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + enc.EncodeToString(signature), nil
+}
+
+type algorithm struct {
+	name string
+	hash crypto.Hash
+	size int // The size of each of the r and s values of an EC signature.
+}
+
+// signingAlgorithm returns the JWS algorithm used to sign with key.
+func signingAlgorithm(key crypto.Signer) (algorithm, error) {
+	switch pub := key.Public().(type) {
+	case *rsa.PublicKey:
+		return algorithm{name: "RS256", hash: crypto.SHA256}, nil
+	case *ecdsa.PublicKey:
+		switch pub.Curve.Params().BitSize {
+		case 256:
+			return algorithm{name: "ES256", hash: crypto.SHA256, size: 32}, nil
+		case 384:
+			return algorithm{name: "ES384", hash: crypto.SHA384, size: 48}, nil
+		case 521:
+			return algorithm{name: "ES512", hash: crypto.SHA512, size: 66}, nil
+		}
+		return algorithm{}, fmt.Errorf("unsupported EC curve %s", pub.Curve.Params().Name)
+	}
+	return algorithm{}, fmt.Errorf("unsupported key type %T", key.Public())
+}
+
+// sign signs the input, returning an EC signature as the concatenation of
+// its r and s values as required by JWS.
+func (a algorithm) sign(key crypto.Signer, input string) ([]byte, error) {
+	h := a.hash.New()
+	h.Write([]byte(input))
+	digest := h.Sum(nil)
+
+	signature, err := key.Sign(rand.Reader, digest, a.hash)
+	if err != nil || a.size == 0 {
+		return signature, err
+	}
+
+	var ec struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(signature, &ec); err != nil {
+		return nil, err
+	}
+	signature = make([]byte, 2*a.size)
+	r, s := ec.R.Bytes(), ec.S.Bytes()
+	copy(signature[a.size-len(r):a.size], r)
+	copy(signature[2*a.size-len(s):], s)
+	return signature, nil
+}
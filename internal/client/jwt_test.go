@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPrivateKeyJWT(t *testing.T) {
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, key := range map[string]crypto.Signer{
+		"RS256": rsaKey,
+		"ES256": ecKey,
+	} {
+		t.Run(name, func(t *testing.T) {
+
+			var uri string
+			jtis := map[string]bool{}
+
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatal(err)
+				}
+				if r.FormValue("client_secret") != "" {
+					t.Error("Expected no client secret to be sent")
+				}
+				if v := r.FormValue("client_assertion_type"); v != clientAssertionType {
+					t.Errorf("Expected client_assertion_type to be %q but got %q", clientAssertionType, v)
+				}
+
+				parts := strings.Split(r.FormValue("client_assertion"), ".")
+				if len(parts) != 3 {
+					t.Fatalf("Expected a JWT but got %q", r.FormValue("client_assertion"))
+				}
+
+				var header map[string]string
+				decodeSegment(t, parts[0], &header)
+				if header["alg"] != name || header["kid"] != "key-id" {
+					t.Errorf("Unexpected header %v", header)
+				}
+
+				var claims map[string]interface{}
+				decodeSegment(t, parts[1], &claims)
+				if claims["iss"] != "client-id" || claims["sub"] != "client-id" || claims["aud"] != uri+"/" {
+					t.Errorf("Unexpected claims %v", claims)
+				}
+				jti, _ := claims["jti"].(string)
+				if jtis[jti] {
+					t.Errorf("Expected a unique jti but got %q twice", jti)
+				}
+				jtis[jti] = true
+
+				signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+				digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+				switch pub := key.Public().(type) {
+				case *rsa.PublicKey:
+					if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature); err != nil {
+						t.Error(err)
+					}
+				case *ecdsa.PublicKey:
+					r := new(big.Int).SetBytes(signature[:32])
+					s := new(big.Int).SetBytes(signature[32:])
+					if !ecdsa.Verify(pub, digest[:], r, s) {
+						t.Error("Expected the assertion signature to be valid")
+					}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":1}`))
+			})
+
+			s := httptest.NewServer(h)
+			defer s.Close()
+			uri = s.URL
+
+			ts := PrivateKeyJWT(context.Background(), uri, "client-id", "key-id", key, nil)
+
+			for i := 0; i < 2; i++ {
+				token, err := ts.Token()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if token.AccessToken != "token" {
+					t.Errorf("Expected access token to be %q but got %q", "token", token.AccessToken)
+				}
+			}
+			if len(jtis) != 2 {
+				t.Errorf("Expected the expired token to be refreshed, but got %d token requests", len(jtis))
+			}
+		})
+	}
+}
+
+func decodeSegment(t *testing.T, segment string, v interface{}) {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// RecorderMode determines whether a Recorder records or replays interactions.
+type RecorderMode int
+
+const (
+	// ModeReplay replays previously recorded interactions, without sending
+	// any request.
+	ModeReplay RecorderMode = iota
+
+	// ModeRecord sends requests and records the interactions.
+	ModeRecord
+)
+
+// Recorder is a transport recording interactions with the server to a
+// fixture file, or replaying them from it.
+//
+// Requests are matched on their method, path, normalized query and body.
+// Identical requests are replayed in the order they were recorded, so that
+// reading a resource before and after updating it returns the right response.
+//
+// Sensitive headers and fields, such as the "Authorization" header and client
+// secrets, are redacted before interactions are saved. Request bodies are
+// redacted the same way before being matched.
+type Recorder struct {
+	path string
+	mode RecorderMode
+	base http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*interaction
+	replayed     []bool
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// NewRecorder returns a Recorder using the fixture file at path. In replay
+// mode the fixture file is loaded, while in record mode requests are sent
+// using the base transport and the fixture is written by Save.
+func NewRecorder(path string, mode RecorderMode, base http.RoundTripper) (*Recorder, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	r := &Recorder{path: path, mode: mode, base: base}
+	if mode == ModeReplay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &r.interactions); err != nil {
+			return nil, fmt.Errorf("recorder: invalid fixture %s: %w", path, err)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	}
+	return r, nil
+}
+
+// RoundTrip records or replays the interaction. The request body is read
+// from a copy of the request, which is sent instead.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded := recordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+	}
+	if req.Body != nil {
+		body := req.Body
+		b := readBody(&body)
+		req = req.Clone(req.Context())
+		req.Body = body
+		recorded.Body = string(redactBody(b, req.Header.Get("Content-Type"), req.URL.Path, redactedFieldSet(nil)))
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	res, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body := readBody(&res.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, &interaction{
+		Request: recorded,
+		Response: recordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     redactHeader(res.Header),
+			Body:       string(redactBody(body, res.Header.Get("Content-Type"), req.URL.Path, redactedFieldSet(nil))),
+		},
+	})
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded recordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.interactions {
+		if r.replayed[i] || in.Request != recorded {
+			continue
+		}
+		r.replayed[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewBufferString(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("recorder: no recorded interaction matches %s %s", req.Method, req.URL.Path)
+}
+
+// Save writes the recorded interactions to the fixture file, creating its
+// directory if needed. It does nothing when replaying.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, b, 0644)
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+
+	var count int
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPatch:
+			w.Write([]byte(`{"name":"updated"}`))
+		default:
+			if count == 1 {
+				w.Write([]byte(`{"name":"original","client_secret":"response-secret"}`))
+				return
+			}
+			w.Write([]byte(`{"name":"updated"}`))
+		}
+	})
+
+	s := httptest.NewServer(h)
+
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "fixture.json")
+
+	send := func(c *http.Client, method, query, body string) (string, error) {
+		req, _ := http.NewRequest(method, s.URL+"/api/v2/clients/123"+query, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		res, err := c.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer res.Body.Close()
+		b, err := ioutil.ReadAll(res.Body)
+		return string(b), err
+	}
+
+	interactions := []struct {
+		method, query, body string
+	}{
+		{"GET", "?b=2&a=1", ""},
+		{"PATCH", "", `{"name":"updated","client_secret":"request-secret"}`},
+		{"GET", "?a=1&b=2", ""},
+	}
+
+	rec, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Wrap(&http.Client{Transport: rec}, StaticToken("bearer-token"))
+
+	var recorded []string
+	for _, i := range interactions {
+		body, err := send(c, i.method, i.query, i.body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorded = append(recorded, body)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"bearer-token", "request-secret", "response-secret"} {
+		if bytes.Contains(b, []byte(secret)) {
+			t.Errorf("Expected %q to be removed from the fixture", secret)
+		}
+	}
+
+	rec, err = NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = Wrap(&http.Client{Transport: rec}, StaticToken("other-token"))
+
+	for n, i := range interactions {
+		body, err := send(c, i.method, i.query, i.body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n > 0 && body != recorded[n] {
+			t.Errorf("Expected interaction %d to replay %q but got %q", n, recorded[n], body)
+		}
+	}
+
+	if _, err := send(c, "GET", "?a=1&b=2", ""); err == nil {
+		t.Error("Expected an interaction replayed already not to match")
+	}
+	if _, err := send(c, "PATCH", "", `{"name":"other"}`); err == nil {
+		t.Error("Expected a request with another body not to match")
+	}
+}
+
+func TestRecorderRequest(t *testing.T) {
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		w.Write(b)
+	}))
+	defer s.Close()
+
+	rec, err := NewRecorder("", ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := ioutil.NopCloser(strings.NewReader(`{"name":"test"}`))
+	req, _ := http.NewRequest("POST", s.URL+"/api/v2/clients", body)
+	res, err := rec.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if req.Body != body {
+		t.Error("Expected the body of the request not to be replaced")
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil || string(b) != `{"name":"test"}` {
+		t.Errorf("Expected the request body to be sent, got %q, %v", b, err)
+	}
+}
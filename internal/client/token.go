@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// contextTokenSource is a token source able to request tokens using the
+// context of the request which needs one, so that its deadline and
+// cancellation also apply to token requests.
+type contextTokenSource interface {
+	oauth2.TokenSource
+	TokenContext(ctx context.Context) (*oauth2.Token, error)
+}
+
+// tokenContext returns a context canceled along with ctx, carrying the values
+// of ctx as well as those of base, such as the HTTP client used for token
+// requests.
+func tokenContext(ctx, base context.Context) context.Context {
+	return valuesContext{ctx, base}
+}
+
+type valuesContext struct {
+	context.Context
+	base context.Context
+}
+
+func (c valuesContext) Value(key interface{}) interface{} {
+	if v := c.Context.Value(key); v != nil {
+		return v
+	}
+	return c.base.Value(key)
+}
+
+// tokenSourceFunc is an adapter to allow the use of ordinary functions as
+// context aware token sources.
+type tokenSourceFunc func(ctx context.Context) (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f(context.Background())
+}
+
+func (f tokenSourceFunc) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	return f(ctx)
+}
+
+// reuseTokenSource returns tokens from src until they expire, sharing them
+// through the cache when it is not nil.
+//
+// Unlike oauth2.ReuseTokenSource, waiting for another request to obtain a
+// token can be canceled.
+type reuseTokenSource struct {
+	src   contextTokenSource
+	lock  chan struct{}
+	token *oauth2.Token
+}
+
+func newReuseTokenSource(src contextTokenSource, cache TokenCache, key TokenCacheKey) *reuseTokenSource {
+	if cache != nil {
+		src = &cachedTokenSource{src, cache, key}
+	}
+	return &reuseTokenSource{src: src, lock: make(chan struct{}, 1)}
+}
+
+func (s *reuseTokenSource) Token() (*oauth2.Token, error) {
+	return s.TokenContext(context.Background())
+}
+
+func (s *reuseTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	select {
+	case s.lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-s.lock }()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	token, err := s.src.TokenContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	return token, nil
+}
+
+// TokenTransport wraps base transport with the ability to authenticate
+// requests using tokens from the token source. Tokens are requested using the
+// context of the request when the token source supports it.
+func TokenTransport(base http.RoundTripper, src oauth2.TokenSource) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var token *oauth2.Token
+		var err error
+		if s, ok := src.(contextTokenSource); ok {
+			token, err = s.TokenContext(req.Context())
+		} else {
+			token, err = src.Token()
+		}
+		if err != nil {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+
+		// Requests must not be modified by transports, so the header is set
+		// on a copy.
+		r := new(http.Request)
+		*r = *req
+		r.Header = make(http.Header, len(req.Header))
+		for k, v := range req.Header {
+			r.Header[k] = v
+		}
+		token.SetAuthHeader(r)
+		return base.RoundTrip(r)
+	})
+}
@@ -14,6 +14,8 @@ type ActionTrigger struct {
 	ID      *string `json:"id"`
 	Version *string `json:"version"`
 	Status  *string `json:"status,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ActionTriggerList struct {
@@ -24,12 +26,16 @@ type ActionDependency struct {
 	Name        *string `json:"name"`
 	Version     *string `json:"version,omitempty"`
 	RegistryURL *string `json:"registry_url,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ActionSecret struct {
 	Name      *string    `json:"name"`
 	Value     *string    `json:"value,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ActionVersionError struct {
@@ -76,6 +82,8 @@ type Action struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// The time when this action was updated.
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ActionList struct {
@@ -83,6 +91,20 @@ type ActionList struct {
 	Actions []*Action `json:"actions"`
 }
 
+// ActionIterator iterates over actions, fetching further pages as needed.
+type ActionIterator struct {
+	it *iterator
+}
+
+// Next returns the next action. It returns Done when there are no more actions.
+func (i *ActionIterator) Next() (*Action, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Action), nil
+}
+
 type ActionVersion struct {
 	ID           *string             `json:"id,omitempty"`
 	Code         *string             `json:"code"`
@@ -104,6 +126,22 @@ type ActionVersionList struct {
 	Versions []*ActionVersion `json:"versions"`
 }
 
+// ActionVersionIterator iterates over action versions, fetching further pages
+// as needed.
+type ActionVersionIterator struct {
+	it *iterator
+}
+
+// Next returns the next action version. It returns Done when there are no more
+// action versions.
+func (i *ActionVersionIterator) Next() (*ActionVersion, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ActionVersion), nil
+}
+
 const (
 	ActionBindingReferenceByName string = "action_name"
 	ActionBindingReferenceById   string = "action_id"
@@ -112,6 +150,8 @@ const (
 type ActionBindingReference struct {
 	Type  *string `json:"type"`
 	Value *string `json:"value"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ActionBinding struct {
@@ -125,6 +165,9 @@ type ActionBinding struct {
 
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ActionBindingList struct {
@@ -132,6 +175,22 @@ type ActionBindingList struct {
 	Bindings []*ActionBinding `json:"bindings"`
 }
 
+// ActionBindingIterator iterates over action bindings, fetching further pages
+// as needed.
+type ActionBindingIterator struct {
+	it *iterator
+}
+
+// Next returns the next action binding. It returns Done when there are no more
+// action bindings.
+func (i *ActionBindingIterator) Next() (*ActionBinding, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ActionBinding), nil
+}
+
 type actionBindingsPerTrigger struct {
 	Bindings []*ActionBinding `json:"bindings"`
 }
@@ -181,7 +240,7 @@ func applyActionsListDefaults(options []RequestOption) RequestOption {
 //
 // https://auth0.com/docs/api/management/v2/#!/Actions/get_triggers
 func (m *ActionManager) Triggers(opts ...RequestOption) (l *ActionTriggerList, err error) {
-	err = m.Request("GET", m.URI("actions", "triggers"), &l, opts...)
+	err = m.Request("GET", m.URI("actions", "triggers"), &l, withOperation("Action.Triggers", opts)...)
 	return
 }
 
@@ -196,14 +255,14 @@ func (m *ActionManager) ListTriggers(opts ...RequestOption) (l *ActionTriggerLis
 //
 // See: https://auth0.com/docs/api/management/v2#!/Actions/post_action
 func (m *ActionManager) Create(a *Action, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("actions", "actions"), a, opts...)
+	return m.Request("POST", m.URI("actions", "actions"), a, withOperation("Action.Create", opts)...)
 }
 
 // Retrieve action details.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Actions/get_action
 func (m *ActionManager) Read(id string, opts ...RequestOption) (a *Action, err error) {
-	err = m.Request("GET", m.URI("actions", "actions", id), &a, opts...)
+	err = m.Request("GET", m.URI("actions", "actions", id), &a, withOperation("Action.Read", opts)...)
 	return
 }
 
@@ -211,29 +270,37 @@ func (m *ActionManager) Read(id string, opts ...RequestOption) (a *Action, err e
 //
 // See: https://auth0.com/docs/api/management/v2#!/Actions/patch_action
 func (m *ActionManager) Update(id string, a *Action, opts ...RequestOption) error {
-	return m.Request("PATCH", m.URI("actions", "actions", id), &a, opts...)
+	return m.Request("PATCH", m.URI("actions", "actions", id), &a, withOperation("Action.Update", opts)...)
 }
 
 // Delete an action
 //
 // See: https://auth0.com/docs/api/management/v2#!/Actions/delete_action
 func (m *ActionManager) Delete(id string, opts ...RequestOption) error {
-	return m.Request("DELETE", m.URI("actions", "actions", id), nil, opts...)
+	return m.Request("DELETE", m.URI("actions", "actions", id), nil, withOperation("Action.Delete", opts)...)
 }
 
 // List all actions.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Actions/get_actions
 func (m *ActionManager) List(opts ...RequestOption) (l *ActionList, err error) {
-	err = m.Request("GET", m.URI("actions", "actions"), &l, applyActionsListDefaults(opts))
+	err = m.Request("GET", m.URI("actions", "actions"), &l, applyActionsListDefaults(withOperation("Action.List", opts)))
 	return
 }
 
+// Iterate returns an iterator over all actions, fetching further pages as the
+// iteration advances.
+func (m *ActionManager) Iterate(opts ...RequestOption) *ActionIterator {
+	return &ActionIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
 // Version retrieves the version of an action.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/get_action_version
 func (m *ActionManager) Version(id string, versionId string, opts ...RequestOption) (v *ActionVersion, err error) {
-	err = m.Request("GET", m.URI("actions", "actions", id, "versions", versionId), &v, opts...)
+	err = m.Request("GET", m.URI("actions", "actions", id, "versions", versionId), &v, withOperation("Action.Version", opts)...)
 	return
 }
 
@@ -248,10 +315,18 @@ func (m *ActionManager) ReadVersion(id string, versionId string, opts ...Request
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/get_action_versions
 func (m *ActionManager) Versions(id string, opts ...RequestOption) (c *ActionVersionList, err error) {
-	err = m.Request("GET", m.URI("actions", "actions", id, "versions"), &c, applyActionsListDefaults(opts))
+	err = m.Request("GET", m.URI("actions", "actions", id, "versions"), &c, applyActionsListDefaults(withOperation("Action.Versions", opts)))
 	return
 }
 
+// IterateVersions returns an iterator over all versions of an action, fetching
+// further pages as the iteration advances.
+func (m *ActionManager) IterateVersions(id string, opts ...RequestOption) *ActionVersionIterator {
+	return &ActionVersionIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Versions(id, opts...)
+	})}
+}
+
 // ListVersions of an action.
 //
 // Deprecated: use Versions() instead.
@@ -266,17 +341,25 @@ func (m *ActionManager) UpdateBindings(triggerID string, b []*ActionBinding, opt
 	bl := &actionBindingsPerTrigger{
 		Bindings: b,
 	}
-	return m.Request("PATCH", m.URI("actions", "triggers", triggerID, "bindings"), &bl, opts...)
+	return m.Request("PATCH", m.URI("actions", "triggers", triggerID, "bindings"), &bl, withOperation("Action.UpdateBindings", opts)...)
 }
 
 // Bindings lists the bindings of a trigger.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/get_bindings
 func (m *ActionManager) Bindings(triggerID string, opts ...RequestOption) (bl *ActionBindingList, err error) {
-	err = m.Request("GET", m.URI("actions", "triggers", triggerID, "bindings"), &bl, applyActionsListDefaults(opts))
+	err = m.Request("GET", m.URI("actions", "triggers", triggerID, "bindings"), &bl, applyActionsListDefaults(withOperation("Action.Bindings", opts)))
 	return
 }
 
+// IterateBindings returns an iterator over the bindings of a trigger, fetching
+// further pages as the iteration advances.
+func (m *ActionManager) IterateBindings(triggerID string, opts ...RequestOption) *ActionBindingIterator {
+	return &ActionBindingIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Bindings(triggerID, opts...)
+	})}
+}
+
 // ListBindings lists the bindings of a trigger.
 //
 // Deprecated: use Bindings() instead.
@@ -288,7 +371,7 @@ func (m *ActionManager) ListBindings(triggerID string, opts ...RequestOption) (b
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/post_deploy_action
 func (m *ActionManager) Deploy(id string, opts ...RequestOption) (v *ActionVersion, err error) {
-	err = m.Request("POST", m.URI("actions", "actions", id, "deploy"), &v, opts...)
+	err = m.Request("POST", m.URI("actions", "actions", id, "deploy"), &v, withOperation("Action.Deploy", opts)...)
 	return
 }
 
@@ -296,7 +379,7 @@ func (m *ActionManager) Deploy(id string, opts ...RequestOption) (v *ActionVersi
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/post_deploy_draft_version
 func (m *ActionManager) DeployVersion(id string, versionId string, opts ...RequestOption) (v *ActionVersion, err error) {
-	err = m.Request("POST", m.URI("actions", "actions", id, "versions", versionId, "deploy"), &v, opts...)
+	err = m.Request("POST", m.URI("actions", "actions", id, "versions", versionId, "deploy"), &v, withOperation("Action.DeployVersion", opts)...)
 	return
 }
 
@@ -307,7 +390,7 @@ func (m *ActionManager) Test(id string, payload *ActionTestPayload, opts ...Requ
 	r := &actionTestRequest{
 		Payload: payload,
 	}
-	err = m.Request("POST", m.URI("actions", "actions", id, "test"), &r, opts...)
+	err = m.Request("POST", m.URI("actions", "actions", id, "test"), &r, withOperation("Action.Test", opts)...)
 	return
 }
 
@@ -315,7 +398,7 @@ func (m *ActionManager) Test(id string, payload *ActionTestPayload, opts ...Requ
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Actions/get_execution
 func (m *ActionManager) Execution(executionId string, opts ...RequestOption) (v *ActionExecution, err error) {
-	err = m.Request("GET", m.URI("actions", "executions", executionId), &v, opts...)
+	err = m.Request("GET", m.URI("actions", "executions", executionId), &v, withOperation("Action.Execution", opts)...)
 	return
 }
 
@@ -199,7 +199,7 @@ func TestActions(t *testing.T) {
 	t.Run("Execution", func(t *testing.T) {
 		_, err := m.Action.Execution("M9IqRp9wQLaYNrSwz6YPTTIwMjEwNDA0")
 		if err != nil {
-			mgmtError, _ := err.(*managementError)
+			mgmtError, _ := err.(*APIError)
 			if mgmtError.StatusCode != 404 {
 				t.Fatal(err)
 			}
@@ -1,6 +1,7 @@
 package management
 
 import (
+	"errors"
 	"net/http"
 )
 
@@ -17,7 +18,7 @@ func newAnomalyManager(m *Management) *AnomalyManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Anomaly/get_ips_by_id
 func (m *AnomalyManager) CheckIP(ip string, opts ...RequestOption) (isBlocked bool, err error) {
-	req, err := m.NewRequest("GET", m.URI("anomaly", "blocks", "ips", ip), nil, opts...)
+	req, err := m.NewRequest("GET", m.URI("anomaly", "blocks", "ips", ip), nil, withOperation("Anomaly.CheckIP", opts)...)
 	if err != nil {
 		return false, err
 	}
@@ -29,15 +30,16 @@ func (m *AnomalyManager) CheckIP(ip string, opts ...RequestOption) (isBlocked bo
 
 	// 200: IP address specified is currently blocked.
 	if res.StatusCode == http.StatusOK {
-		return true, nil
+		return true, res.Body.Close()
 	}
 
 	// 404: IP address specified is not currently blocked.
-	if res.StatusCode == http.StatusNotFound {
+	err = newError(res)
+	if errors.Is(err, ErrNotFound) {
 		return false, nil
 	}
 
-	return false, newError(res.Body)
+	return false, err
 }
 
 // Unblock an IP address currently blocked by the multiple user accounts
@@ -45,5 +47,5 @@ func (m *AnomalyManager) CheckIP(ip string, opts ...RequestOption) (isBlocked bo
 //
 // See: https://auth0.com/docs/api/management/v2#!/Anomaly/delete_ips_by_id
 func (m *AnomalyManager) UnblockIP(ip string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("anomaly", "blocks", "ips", ip), nil, opts...)
+	return m.Request("DELETE", m.URI("anomaly", "blocks", "ips", ip), nil, withOperation("Anomaly.UnblockIP", opts)...)
 }
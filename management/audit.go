@@ -0,0 +1,265 @@
+package management
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/auth0.v5/internal/client"
+)
+
+// AuditEvent describes a request modifying resources sent to the Auth0
+// Management API, whether it succeeded or not.
+type AuditEvent struct {
+	// The time the request was sent.
+	Time time.Time `json:"time"`
+
+	// The actor on whose behalf the request was sent, as set on the context
+	// of the request using ContextWithActor.
+	Actor string `json:"actor,omitempty"`
+
+	// The logical operation, made of the manager and method names, such as
+	// "Client.Update". Empty when the request was not sent by a manager, nor
+	// named using Operation.
+	Operation string `json:"operation,omitempty"`
+
+	// The HTTP method and path of the request.
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// The identifier of the resource concerned, taken from the path of the
+	// request, or from the response when creating a resource.
+	ResourceID string `json:"resource_id,omitempty"`
+
+	// The payload of the request, where sensitive fields such as secrets are
+	// redacted.
+	Payload json.RawMessage `json:"payload,omitempty"`
+
+	// The status code of the response, or zero when the request failed.
+	StatusCode int `json:"status_code,omitempty"`
+
+	// The error which caused the request to fail, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink receives an AuditEvent for each request modifying resources sent
+// by a management client configured using WithAuditSink. It must be safe for
+// concurrent use.
+type AuditSink interface {
+	Audit(ctx context.Context, event AuditEvent)
+}
+
+// WithAuditSink configures the management client to send an AuditEvent to
+// the sink for every request modifying resources, once it has succeeded or
+// failed.
+//
+// Requests rejected by WithReadOnly or guard rules, as well as those recorded
+// by WithDryRun, are not sent and therefore not audited.
+func WithAuditSink(sink AuditSink) ManagementOption {
+	return func(m *Management) {
+		m.auditSink = sink
+	}
+}
+
+type actorKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the identifier of the actor
+// on whose behalf requests are sent, which is included in audit events.
+//
+//     ctx := management.ContextWithActor(ctx, "deploy-bot")
+//     err := m.Client.UpdateContext(ctx, id, c)
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set on ctx using ContextWithActor, if
+// any.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// doWithAudit sends the request, notifying the audit sink afterwards.
+func (m *Management) doWithAudit(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	event := AuditEvent{
+		Time:       time.Now(),
+		Actor:      ActorFromContext(req.Context()),
+		Operation:  operation(req),
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		ResourceID: resourceID(req.URL.Path),
+	}
+	if b, err := requestBody(req); err == nil {
+		event.Payload = auditPayload(client.Redact(b, req.Header.Get("Content-Type"), req.URL.Path, m.debugger.Fields...))
+	}
+
+	res, err := send(req)
+	if err != nil {
+		event.Error = err.Error()
+	}
+	if res != nil {
+		event.StatusCode = res.StatusCode
+		if event.ResourceID == "" && res.StatusCode < http.StatusBadRequest {
+			event.ResourceID = createdResourceID(res)
+		}
+	}
+
+	m.auditSink.Audit(req.Context(), event)
+	return res, err
+}
+
+// requestBody returns the body of the request, leaving it to be sent.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return ioutil.ReadAll(body)
+	}
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+// auditPayload returns the payload as JSON, quoting it if it is not.
+func auditPayload(b []byte) json.RawMessage {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return nil
+	}
+	if json.Valid(b) {
+		return b
+	}
+	q, _ := json.Marshal(string(b))
+	return q
+}
+
+// resourceID returns the first resource identifier found in the path.
+func resourceID(path string) string {
+	for _, s := range strings.Split(path, "/") {
+		if s != "" && !pathSegments[s] {
+			return s
+		}
+	}
+	return ""
+}
+
+// createdResourceID returns the identifier of the resource in the response,
+// leaving the response body unread.
+func createdResourceID(res *http.Response) string {
+	if res.Body == nil || res.Body == http.NoBody {
+		return ""
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return ""
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return ""
+	}
+	for _, k := range []string{"id", "client_id", "user_id"} {
+		if id, ok := v[k].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+// MemoryAuditSink is an AuditSink keeping events in memory, which is mostly
+// useful for testing. Its zero value is ready to use.
+type MemoryAuditSink struct {
+	mu     sync.Mutex
+	events []AuditEvent
+}
+
+// NewMemoryAuditSink returns an empty MemoryAuditSink.
+func NewMemoryAuditSink() *MemoryAuditSink {
+	return &MemoryAuditSink{}
+}
+
+// Audit keeps the event.
+func (s *MemoryAuditSink) Audit(ctx context.Context, event AuditEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+// Events returns the events received so far, in order.
+func (s *MemoryAuditSink) Events() []AuditEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]AuditEvent(nil), s.events...)
+}
+
+// JSONLinesAuditSink is an AuditSink writing events as JSON, one per line.
+type JSONLinesAuditSink struct {
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+}
+
+// NewJSONLinesAuditSink returns a JSONLinesAuditSink writing events to w.
+func NewJSONLinesAuditSink(w io.Writer) *JSONLinesAuditSink {
+	return &JSONLinesAuditSink{w: w}
+}
+
+// OpenJSONLinesAuditSink returns a JSONLinesAuditSink appending events to the
+// file at path, which is created if needed. The file is closed by Close.
+func OpenJSONLinesAuditSink(path string) (*JSONLinesAuditSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return NewJSONLinesAuditSink(f), nil
+}
+
+// Audit writes the event. The first error writing an event is returned by
+// Err.
+func (s *JSONLinesAuditSink) Audit(ctx context.Context, event AuditEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := json.Marshal(event)
+	if err == nil {
+		_, err = s.w.Write(append(b, '\n'))
+	}
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+}
+
+// Err returns the first error which occurred writing events, if any.
+func (s *JSONLinesAuditSink) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close closes the underlying writer if it is an io.Closer, and returns the
+// first error which occurred writing events, if any.
+func (s *JSONLinesAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.w.(io.Closer); ok {
+		if err := c.Close(); err != nil && s.err == nil {
+			s.err = err
+		}
+	}
+	return s.err
+}
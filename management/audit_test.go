@@ -0,0 +1,152 @@
+package management
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestAudit(t *testing.T) {
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"client_id":"new","name":"app","client_secret":"generated"}`))
+		case http.MethodDelete:
+			http.NotFound(w, r)
+		default:
+			w.Write([]byte(`{"client_id":"app"}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file, err := OpenJSONLinesAuditSink(filepath.Join(dir, "audit.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	memory := NewMemoryAuditSink()
+
+	var events []AuditEvent
+	for _, sink := range []AuditSink{memory, file} {
+		m, err := New(s.URL, WithInsecure(), WithAuditSink(sink))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := ContextWithActor(context.Background(), "deploy-bot")
+
+		c := &Client{Name: auth0.String("app"), ClientSecret: auth0.String("secret")}
+		if err := m.Client.CreateContext(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, c.GetClientSecret(), "generated")
+
+		if _, err := m.Client.ReadContext(ctx, "app"); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Client.DeleteContext(ctx, "app"); err == nil {
+			t.Fatal("Expected an error deleting the client")
+		}
+	}
+
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(filepath.Join(dir, "audit.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e AuditEvent
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, e)
+	}
+
+	for _, events := range [][]AuditEvent{memory.Events(), events} {
+		if len(events) != 2 {
+			t.Fatalf("Expected 2 events, got %d", len(events))
+		}
+
+		created := events[0]
+		if created.Time.IsZero() {
+			t.Error("Expected the event time to be set")
+		}
+		expect.Expect(t, created.Actor, "deploy-bot")
+		expect.Expect(t, created.Operation, "Client.Create")
+		expect.Expect(t, created.Method, http.MethodPost)
+		expect.Expect(t, created.Path, "/api/v2/clients")
+		expect.Expect(t, created.ResourceID, "new")
+		expect.Expect(t, string(created.Payload), `{"client_secret":"[REDACTED]","name":"app"}`)
+		expect.Expect(t, created.StatusCode, http.StatusCreated)
+		expect.Expect(t, created.Error, "")
+
+		deleted := events[1]
+		expect.Expect(t, deleted.Operation, "Client.Delete")
+		expect.Expect(t, deleted.ResourceID, "app")
+		expect.Expect(t, len(deleted.Payload), 0)
+		expect.Expect(t, deleted.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestAuditRequestBody(t *testing.T) {
+
+	var received []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received = append(received, string(b))
+		w.Write([]byte(`{"client_id":"app"}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	sink := NewMemoryAuditSink()
+	m, err := New(s.URL, WithInsecure(), WithAuditSink(sink))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Client.Update("app", &Client{Name: auth0.String("app")}, Body([]byte(`{"name":"raw"}`))); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, m.URI("clients", "app"), ioutil.NopCloser(strings.NewReader(`{"name":"custom"}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := m.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	expect.Expect(t, received, []string{`{"name":"raw"}`, `{"name":"custom"}`})
+	events := sink.Events()
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+	expect.Expect(t, string(events[0].Payload), `{"name":"raw"}`)
+	expect.Expect(t, string(events[1].Payload), `{"name":"custom"}`)
+}
@@ -35,7 +35,7 @@ func newBlacklistManager(m *Management) *BlacklistManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Blacklists/get_tokens
 func (m *BlacklistManager) List(opts ...RequestOption) (bl []*BlacklistToken, err error) {
-	err = m.Request("GET", m.URI("blacklists", "tokens"), &bl, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("blacklists", "tokens"), &bl, applyListDefaults(withOperation("Blacklist.List", opts)))
 	return
 }
 
@@ -43,5 +43,5 @@ func (m *BlacklistManager) List(opts ...RequestOption) (bl []*BlacklistToken, er
 //
 // See: https://auth0.com/docs/api/management/v2#!/Blacklists/post_tokens
 func (m *BlacklistManager) Create(t *BlacklistToken, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("blacklists", "tokens"), t, opts...)
+	return m.Request("POST", m.URI("blacklists", "tokens"), t, withOperation("Blacklist.Create", opts)...)
 }
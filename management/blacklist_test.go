@@ -9,7 +9,7 @@ import (
 
 func TestBlacklist(t *testing.T) {
 	c := &Client{
-		Name: auth0.Stringf("Test Client - Blacklist (%s)", time.Now().Format(time.StampMilli)),
+		Name: auth0.Stringf("Test Client - Blacklist (%s)", testTime().Format(time.StampMilli)),
 	}
 	err := m.Client.Create(c)
 	if err != nil {
@@ -17,6 +17,9 @@ type Branding struct {
 	LogoURL *string `json:"logo_url,omitempty"`
 
 	Font *BrandingFont `json:"font,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type BrandingColors struct {
@@ -34,6 +37,9 @@ type BrandingColors struct {
 	// Only one of PageBackground and PageBackgroundGradient should be set. If
 	// both fields are set, PageBackground takes priority.
 	PageBackgroundGradient *BrandingPageBackgroundGradient `json:"-"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type BrandingPageBackgroundGradient struct {
@@ -41,6 +47,8 @@ type BrandingPageBackgroundGradient struct {
 	Start       *string `json:"start,omitempty"`
 	End         *string `json:"end,omitempty"`
 	AngleDegree *int    `json:"angle_deg,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 // MarshalJSON implements the json.Marshaler interface.
@@ -64,7 +72,11 @@ func (bc *BrandingColors) MarshalJSON() ([]byte, error) {
 		alias.RawPageBackground = bc.PageBackgroundGradient
 	}
 
-	return json.Marshal(alias)
+	b, err := json.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(b, bc.Extra)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -111,16 +123,21 @@ func (bc *BrandingColors) UnmarshalJSON(data []byte) error {
 		}
 	}
 
-	return nil
+	bc.Extra, err = unmarshalExtra(data, alias)
+	return err
 }
 
 type BrandingFont struct {
 	// URL for the custom font. Must use HTTPS.
 	URL *string `json:"url,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type BrandingUniversalLogin struct {
 	Body *string `json:"body,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type BrandingManager struct {
@@ -135,7 +152,7 @@ func newBrandingManager(m *Management) *BrandingManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Branding/get_branding
 func (m *BrandingManager) Read(opts ...RequestOption) (b *Branding, err error) {
-	err = m.Request("GET", m.URI("branding"), &b, opts...)
+	err = m.Request("GET", m.URI("branding"), &b, withOperation("Branding.Read", opts)...)
 	return
 }
 
@@ -143,14 +160,14 @@ func (m *BrandingManager) Read(opts ...RequestOption) (b *Branding, err error) {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Branding/patch_branding
 func (m *BrandingManager) Update(t *Branding, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("branding"), t, opts...)
+	return m.Request("PATCH", m.URI("branding"), t, withOperation("Branding.Update", opts)...)
 }
 
 // Retrieve template for New Universal Login Experience.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Branding/get_universal_login
 func (m *BrandingManager) UniversalLogin(opts ...RequestOption) (ul *BrandingUniversalLogin, err error) {
-	err = m.Request("GET", m.URI("branding", "templates", "universal-login"), &ul, opts...)
+	err = m.Request("GET", m.URI("branding", "templates", "universal-login"), &ul, withOperation("Branding.UniversalLogin", opts)...)
 	return
 }
 
@@ -159,18 +176,18 @@ func (m *BrandingManager) UniversalLogin(opts ...RequestOption) (ul *BrandingUni
 // See: https://auth0.com/docs/api/management/v2#!/Branding/put_universal_login
 func (m *BrandingManager) SetUniversalLogin(ul *BrandingUniversalLogin, opts ...RequestOption) (err error) {
 
-	req, err := m.NewRequest("PUT", m.URI("branding", "templates", "universal-login"), ul.Body, opts...)
+	req, err := m.NewRequest("PUT", m.URI("branding", "templates", "universal-login"), ul.Body, withOperation("Branding.SetUniversalLogin", opts)...)
 	if err != nil {
 		return err
 	}
 
-	res, err := m.Do(req)
+	res, err := m.Do(withPayload(req, ul))
 	if err != nil {
 		return err
 	}
 
 	if res.StatusCode >= http.StatusBadRequest {
-		return newError(res.Body)
+		return newError(res)
 	}
 
 	return nil
@@ -180,5 +197,5 @@ func (m *BrandingManager) SetUniversalLogin(ul *BrandingUniversalLogin, opts ...
 //
 // See: https://auth0.com/docs/api/management/v2#!/Branding/delete_universal_login
 func (m *BrandingManager) DeleteUniversalLogin(opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("branding", "templates", "universal-login"), nil, opts...)
+	return m.Request("DELETE", m.URI("branding", "templates", "universal-login"), nil, withOperation("Branding.DeleteUniversalLogin", opts)...)
 }
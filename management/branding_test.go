@@ -3,7 +3,6 @@ package management
 import (
 	"encoding/json"
 	"testing"
-	"time"
 
 	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/internal/testing/expect"
@@ -70,7 +69,7 @@ func TestBranding(t *testing.T) {
 	})
 
 	c := &CustomDomain{
-		Domain: auth0.Stringf("%d.auth.uat.alexkappa.com", time.Now().UTC().Unix()),
+		Domain: auth0.Stringf("%d.auth.uat.alexkappa.com", testTime().UTC().Unix()),
 		Type:   auth0.String("auth0_managed_certs"),
 	}
 
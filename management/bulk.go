@@ -0,0 +1,279 @@
+package management
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BulkExecutor runs an operation on many items concurrently, such as
+// assigning roles to every user of an organization.
+//
+//     b := &management.BulkExecutor{Concurrency: 5, Checkpoint: "roles.checkpoint"}
+//     err := b.Run(ctx, userIDs, func(ctx context.Context, id string) error {
+//         return m.User.AssignRolesContext(ctx, id, roles)
+//     })
+//
+// Operations failing because of the rate limit are retried once it has been
+// reset, pausing the other operations meanwhile. Other failures do not stop
+// the remaining operations, and are reported together by a *BulkError.
+//
+// The zero value of any field selects its default.
+type BulkExecutor struct {
+	// The maximum number of operations run concurrently. Defaults to 10.
+	Concurrency int
+
+	// The maximum number of times an operation is retried when rate limited.
+	// Defaults to 5.
+	MaxRetries int
+
+	// The delay before retrying an operation when the rate limit reset time
+	// is unknown, doubled on each retry. Defaults to 1 second.
+	RetryDelay time.Duration
+
+	// Progress, when set, is called each time an operation completes. Calls
+	// are not concurrent.
+	Progress func(BulkProgress)
+
+	// Checkpoint, when set, is the path of a file recording the items whose
+	// operation succeeded, which are skipped when running again, for example
+	// after a crash. The file is created if needed.
+	Checkpoint string
+}
+
+// BulkProgress reports the progress of BulkExecutor.Run.
+type BulkProgress struct {
+	// The number of items, including skipped ones.
+	Total int
+
+	// The number of items whose operation succeeded, failed, or which were
+	// skipped as recorded by the checkpoint.
+	Succeeded int
+	Failed    int
+	Skipped   int
+}
+
+// BulkError is the error returned by BulkExecutor.Run when operations failed.
+type BulkError struct {
+	// The errors of the failed operations, by item.
+	Errors map[string]error
+}
+
+func (e *BulkError) Error() string {
+	items := make([]string, 0, len(e.Errors))
+	for item := range e.Errors {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+	if len(items) == 1 {
+		return fmt.Sprintf("bulk operation failed for %s: %s", items[0], e.Errors[items[0]])
+	}
+	return fmt.Sprintf("bulk operation failed for %d items, including %s: %s", len(items), items[0], e.Errors[items[0]])
+}
+
+// Run runs op on each item, returning a *BulkError if any failed. Items
+// should be unique, such as user IDs.
+//
+// Once ctx is done, no further operation is started and its error is
+// returned.
+func (b *BulkExecutor) Run(ctx context.Context, items []string, op func(ctx context.Context, item string) error) error {
+	concurrency := b.Concurrency
+	if concurrency <= 0 {
+		concurrency = 10
+	}
+
+	done, err := loadCheckpoint(b.Checkpoint)
+	if err != nil {
+		return err
+	}
+	var checkpoint *os.File
+	if b.Checkpoint != "" {
+		checkpoint, err = os.OpenFile(b.Checkpoint, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		if err != nil {
+			return err
+		}
+		defer checkpoint.Close()
+	}
+
+	var (
+		mu       sync.Mutex
+		progress = BulkProgress{Total: len(items)}
+		errs     = make(map[string]error)
+		pause    = &bulkPause{}
+	)
+	complete := func(item string, err error) error {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case err != nil:
+			progress.Failed++
+			errs[item] = err
+		case checkpoint != nil:
+			line, _ := json.Marshal(item)
+			if _, err := checkpoint.Write(append(line, '\n')); err != nil {
+				return err
+			}
+			fallthrough
+		default:
+			progress.Succeeded++
+		}
+		if b.Progress != nil {
+			b.Progress(progress)
+		}
+		return nil
+	}
+
+	work := make(chan string)
+	fatal := make(chan error, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range work {
+				if err := complete(item, b.run(ctx, pause, item, op)); err != nil {
+					fatal <- err
+					return
+				}
+			}
+		}()
+	}
+
+	var runErr error
+feed:
+	for _, item := range items {
+		if done[item] {
+			mu.Lock()
+			progress.Skipped++
+			if b.Progress != nil {
+				b.Progress(progress)
+			}
+			mu.Unlock()
+			continue
+		}
+		select {
+		case work <- item:
+		case runErr = <-fatal:
+			break feed
+		case <-ctx.Done():
+			runErr = ctx.Err()
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if runErr != nil {
+		return runErr
+	}
+	select {
+	case err := <-fatal:
+		return err
+	default:
+	}
+	if len(errs) > 0 {
+		return &BulkError{errs}
+	}
+	return nil
+}
+
+// run runs op on item, retrying it while rate limited.
+func (b *BulkExecutor) run(ctx context.Context, pause *bulkPause, item string, op func(ctx context.Context, item string) error) error {
+	maxRetries := b.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 5
+	}
+	retryDelay := b.RetryDelay
+	if retryDelay <= 0 {
+		retryDelay = time.Second
+	}
+
+	for retry := 0; ; retry++ {
+		if err := pause.wait(ctx); err != nil {
+			return err
+		}
+		err := op(ctx, item)
+		if err == nil || !errors.Is(err, ErrRateLimited) || retry == maxRetries {
+			return err
+		}
+		pause.until(rateLimitReset(err, retryDelay<<uint(retry)))
+	}
+}
+
+// rateLimitReset returns the time at which the rate limit is reset according
+// to the error, or after delay if unknown.
+func rateLimitReset(err error, delay time.Duration) time.Time {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Header != nil {
+		if reset, err := strconv.ParseInt(apiErr.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return time.Unix(reset, 0)
+		}
+	}
+	return time.Now().Add(delay)
+}
+
+// bulkPause pauses the operations of a bulk executor until the rate limit is
+// reset.
+type bulkPause struct {
+	mu    sync.Mutex
+	reset time.Time
+}
+
+func (p *bulkPause) until(t time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t.After(p.reset) {
+		p.reset = t
+	}
+}
+
+func (p *bulkPause) wait(ctx context.Context) error {
+	p.mu.Lock()
+	d := time.Until(p.reset)
+	p.mu.Unlock()
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loadCheckpoint returns the items recorded by the checkpoint file at path,
+// ignoring a truncated last line.
+func loadCheckpoint(path string) (map[string]bool, error) {
+	done := make(map[string]bool)
+	if path == "" {
+		return done, nil
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return done, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var item string
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &item); err == nil {
+			done[item] = true
+		}
+	}
+	return done, s.Err()
+}
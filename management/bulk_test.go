@@ -0,0 +1,124 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestBulkExecutor(t *testing.T) {
+
+	var items []string
+	for i := 0; i < 50; i++ {
+		items = append(items, strconv.Itoa(i))
+	}
+
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	checkpoint := filepath.Join(dir, "checkpoint")
+
+	errFailed := errors.New("failed")
+	rateLimited := &APIError{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(time.Now().Unix(), 10)}},
+	}
+
+	var (
+		mu          sync.Mutex
+		calls       = make(map[string]int)
+		running     int32
+		maxRunning  int32
+		lastReport  BulkProgress
+		reportCount int
+	)
+	b := &BulkExecutor{
+		Concurrency: 3,
+		Checkpoint:  checkpoint,
+		Progress: func(p BulkProgress) {
+			lastReport = p
+			reportCount++
+		},
+	}
+	op := func(ctx context.Context, item string) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		calls[item]++
+		call := calls[item]
+		mu.Unlock()
+
+		switch {
+		case item == "7" && call == 1:
+			return fmt.Errorf("request failed: %w", rateLimited)
+		case item == "13" || item == "42":
+			return errFailed
+		}
+		return nil
+	}
+
+	err = b.Run(context.Background(), items, op)
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("Expected a *BulkError, got %v", err)
+	}
+	expect.Expect(t, bulkErr.Errors, map[string]error{"13": errFailed, "42": errFailed})
+	expect.Expect(t, calls["7"], 2)
+	expect.Expect(t, lastReport, BulkProgress{Total: 50, Succeeded: 48, Failed: 2})
+	expect.Expect(t, reportCount, 50)
+	if maxRunning > 3 {
+		t.Errorf("Expected at most 3 concurrent operations, got %d", maxRunning)
+	}
+
+	// Resuming only runs the operations which failed.
+	calls = make(map[string]int)
+	err = b.Run(context.Background(), items, func(ctx context.Context, item string) error {
+		mu.Lock()
+		calls[item]++
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, calls, map[string]int{"13": 1, "42": 1})
+	expect.Expect(t, lastReport, BulkProgress{Total: 50, Succeeded: 2, Skipped: 48})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var count int32
+		err := (&BulkExecutor{Concurrency: 1}).Run(ctx, items, func(ctx context.Context, item string) error {
+			if atomic.AddInt32(&count, 1) == 5 {
+				cancel()
+			}
+			return nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if count >= 50 {
+			t.Errorf("Expected the remaining operations not to run, ran %d", count)
+		}
+	})
+}
@@ -95,6 +95,9 @@ type Client struct {
 
 	OrganizationUsage           *string `json:"organization_usage,omitempty"`
 	OrganizationRequireBehavior *string `json:"organization_require_behavior,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ClientJWTConfiguration struct {
@@ -109,6 +112,9 @@ type ClientJWTConfiguration struct {
 
 	// Algorithm used to sign JWTs. Can be "HS256" or "RS256"
 	Algorithm *string `json:"alg,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ClientNativeSocialLogin struct {
@@ -117,6 +123,9 @@ type ClientNativeSocialLogin struct {
 
 	// Native Social Login support for the Facebook connection
 	Facebook map[string]interface{} `json:"facebook,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ClientRefreshToken struct {
@@ -147,6 +156,9 @@ type ClientRefreshToken struct {
 
 	// Period in seconds after which inactive refresh tokens will expire
 	IdleTokenLifetime *int `json:"idle_token_lifetime,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ClientList struct {
@@ -154,6 +166,20 @@ type ClientList struct {
 	Clients []*Client `json:"clients"`
 }
 
+// ClientIterator iterates over clients, fetching further pages as needed.
+type ClientIterator struct {
+	it *iterator
+}
+
+// Next returns the next client. It returns Done when there are no more clients.
+func (i *ClientIterator) Next() (*Client, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Client), nil
+}
+
 type ClientManager struct {
 	*Management
 }
@@ -166,14 +192,14 @@ func newClientManager(m *Management) *ClientManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/post_clients
 func (m *ClientManager) Create(c *Client, opts ...RequestOption) (err error) {
-	return m.Request("POST", m.URI("clients"), c, opts...)
+	return m.Request("POST", m.URI("clients"), c, withOperation("Client.Create", opts)...)
 }
 
 // Read a client by its id.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/get_clients_by_id
 func (m *ClientManager) Read(id string, opts ...RequestOption) (c *Client, err error) {
-	err = m.Request("GET", m.URI("clients", id), &c, opts...)
+	err = m.Request("GET", m.URI("clients", id), &c, withOperation("Client.Read", opts)...)
 	return
 }
 
@@ -181,22 +207,30 @@ func (m *ClientManager) Read(id string, opts ...RequestOption) (c *Client, err e
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/get_clients
 func (m *ClientManager) List(opts ...RequestOption) (c *ClientList, err error) {
-	err = m.Request("GET", m.URI("clients"), &c, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("clients"), &c, applyListDefaults(withOperation("Client.List", opts)))
 	return
 }
 
+// Iterate returns an iterator over all client applications, fetching further
+// pages as the iteration advances.
+func (m *ClientManager) Iterate(opts ...RequestOption) *ClientIterator {
+	return &ClientIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
 // Update a client.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/patch_clients_by_id
 func (m *ClientManager) Update(id string, c *Client, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("clients", id), c, opts...)
+	return m.Request("PATCH", m.URI("clients", id), c, withOperation("Client.Update", opts)...)
 }
 
 // RotateSecret rotates a client secret.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/post_rotate_secret
 func (m *ClientManager) RotateSecret(id string, opts ...RequestOption) (c *Client, err error) {
-	err = m.Request("POST", m.URI("clients", id, "rotate-secret"), &c, opts...)
+	err = m.Request("POST", m.URI("clients", id, "rotate-secret"), &c, withOperation("Client.RotateSecret", opts)...)
 	return
 }
 
@@ -205,7 +239,7 @@ func (m *ClientManager) RotateSecret(id string, opts ...RequestOption) (c *Clien
 //
 // See: https://auth0.com/docs/api/management/v2#!/Clients/delete_clients_by_id
 func (m *ClientManager) Delete(id string, opts ...RequestOption) error {
-	return m.Request("DELETE", m.URI("clients", id), nil, opts...)
+	return m.Request("DELETE", m.URI("clients", id), nil, withOperation("Client.Delete", opts)...)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -243,7 +277,8 @@ func (jc *ClientJWTConfiguration) UnmarshalJSON(b []byte) error {
 		}
 	}
 
-	return nil
+	jc.Extra, err = unmarshalExtra(b, alias)
+	return err
 }
 
 func (jc *ClientJWTConfiguration) MarshalJSON() ([]byte, error) {
@@ -258,5 +293,9 @@ func (jc *ClientJWTConfiguration) MarshalJSON() ([]byte, error) {
 		alias.RawLifetimeInSeconds = jc.LifetimeInSeconds
 	}
 
-	return json.Marshal(alias)
+	b, err := json.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(b, jc.Extra)
 }
@@ -1,5 +1,7 @@
 package management
 
+import "net/http"
+
 type ClientGrant struct {
 
 	// A generated string identifying the client grant.
@@ -12,6 +14,9 @@ type ClientGrant struct {
 	Audience *string `json:"audience,omitempty"`
 
 	Scope []interface{} `json:"scope"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ClientGrantList struct {
@@ -19,6 +24,22 @@ type ClientGrantList struct {
 	ClientGrants []*ClientGrant `json:"client_grants"`
 }
 
+// ClientGrantIterator iterates over client grants, fetching further pages as
+// needed.
+type ClientGrantIterator struct {
+	it *iterator
+}
+
+// Next returns the next client grant. It returns Done when there are no more
+// client grants.
+func (i *ClientGrantIterator) Next() (*ClientGrant, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ClientGrant), nil
+}
+
 type ClientGrantManager struct {
 	*Management
 }
@@ -31,7 +52,7 @@ func newClientGrantManager(m *Management) *ClientGrantManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Client_Grants/post_client_grants
 func (m *ClientGrantManager) Create(g *ClientGrant, opts ...RequestOption) (err error) {
-	return m.Request("POST", m.URI("client-grants"), g, opts...)
+	return m.Request("POST", m.URI("client-grants"), g, withOperation("ClientGrant.Create", opts)...)
 }
 
 // Retrieves a client grant by its id.
@@ -40,25 +61,22 @@ func (m *ClientGrantManager) Create(g *ClientGrant, opts ...RequestOption) (err
 // by id, we fake this by listing all client grants and matching by id on the
 // client side. For this reason this method should be used with caution.
 func (m *ClientGrantManager) Read(id string, opts ...RequestOption) (*ClientGrant, error) {
-	var page int
+	it := m.Iterate(opts...)
 	for {
-		l, err := m.List(append(opts, Page(page))...)
+		g, err := it.Next()
+		if err == Done {
+			break
+		}
 		if err != nil {
 			return nil, err
 		}
-		for _, g := range l.ClientGrants {
-			if g.GetID() == id {
-				return g, nil
-			}
-		}
-		if !l.HasNext() {
-			break
+		if g.GetID() == id {
+			return g, nil
 		}
-		page++
 	}
-	return nil, &managementError{
-		StatusCode: 404,
-		Err:        "Not Found",
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Err:        http.StatusText(http.StatusNotFound),
 		Message:    "Client grant not found",
 	}
 }
@@ -67,14 +85,14 @@ func (m *ClientGrantManager) Read(id string, opts ...RequestOption) (*ClientGran
 //
 // See: https://auth0.com/docs/api/management/v2#!/Client_Grants/patch_client_grants_by_id
 func (m *ClientGrantManager) Update(id string, g *ClientGrant, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("client-grants", id), g, opts...)
+	return m.Request("PATCH", m.URI("client-grants", id), g, withOperation("ClientGrant.Update", opts)...)
 }
 
 // Delete a client grant.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Client_Grants/delete_client_grants_by_id
 func (m *ClientGrantManager) Delete(id string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("client-grants", id), nil, opts...)
+	return m.Request("DELETE", m.URI("client-grants", id), nil, withOperation("ClientGrant.Delete", opts)...)
 }
 
 // List all client grants.
@@ -84,6 +102,14 @@ func (m *ClientGrantManager) Delete(id string, opts ...RequestOption) (err error
 //
 // See: https://auth0.com/docs/api/management/v2#!/Client_Grants/get_client_grants
 func (m *ClientGrantManager) List(opts ...RequestOption) (gs *ClientGrantList, err error) {
-	err = m.Request("GET", m.URI("client-grants"), &gs, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("client-grants"), &gs, applyListDefaults(withOperation("ClientGrant.List", opts)))
 	return
 }
+
+// Iterate returns an iterator over all client grants, fetching further pages as
+// the iteration advances.
+func (m *ClientGrantManager) Iterate(opts ...RequestOption) *ClientGrantIterator {
+	return &ClientGrantIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
@@ -16,7 +16,7 @@ func TestClientGrant(t *testing.T) {
 
 	c := &Client{
 		Name: auth0.Stringf("Test Client - Client Grant (%s)",
-			time.Now().Format(time.StampMilli)),
+			testTime().Format(time.StampMilli)),
 	}
 	err = m.Client.Create(c)
 	if err != nil {
@@ -26,7 +26,7 @@ func TestClientGrant(t *testing.T) {
 
 	s := &ResourceServer{
 		Name: auth0.Stringf("Test Client Grant (%s)",
-			time.Now().Format(time.StampMilli)),
+			testTime().Format(time.StampMilli)),
 		Identifier: auth0.String("https://api.example.com/client-grant"),
 		Scopes: []*ResourceServerScope{
 			{
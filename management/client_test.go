@@ -14,7 +14,7 @@ func TestClient(t *testing.T) {
 
 	c := &Client{
 		Name: auth0.Stringf("Test Client (%s)",
-			time.Now().Format(time.StampMilli)),
+			testTime().Format(time.StampMilli)),
 		Description: auth0.String("This is just a test client."),
 	}
 
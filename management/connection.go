@@ -2,6 +2,7 @@ package management
 
 import (
 	"encoding/json"
+	"net/http"
 	"sort"
 	"strings"
 
@@ -76,6 +77,9 @@ type Connection struct {
 
 	// Provisioning Ticket URL is Ticket URL for Active Directory/LDAP, etc.
 	ProvisioningTicketUrl *string `json:"provisioning_ticket_url,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *Connection) MarshalJSON() ([]byte, error) {
@@ -96,7 +100,11 @@ func (c *Connection) MarshalJSON() ([]byte, error) {
 		w.RawOptions = b
 	}
 
-	return json.Marshal(w)
+	b, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(b, c.Extra)
 }
 
 func (c *Connection) UnmarshalJSON(b []byte) error {
@@ -167,7 +175,8 @@ func (c *Connection) UnmarshalJSON(b []byte) error {
 		c.Options = v
 	}
 
-	return nil
+	c.Extra, err = unmarshalExtra(b, w)
+	return err
 }
 
 type ConnectionOptions struct {
@@ -215,6 +224,9 @@ type ConnectionOptions struct {
 
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsGoogleOAuth2 struct {
@@ -256,6 +268,9 @@ type ConnectionOptionsGoogleOAuth2 struct {
 	SetUserAttributes      *string       `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs     *[]string     `json:"non_persistent_attrs,omitempty"`
 	Scope                  []interface{} `json:"scope,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsGoogleOAuth2) Scopes() []string {
@@ -316,6 +331,9 @@ type ConnectionOptionsFacebook struct {
 
 	// Scope is a comma separated list of scopes.
 	Scope *string `json:"scope,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsFacebook) Scopes() []string {
@@ -339,6 +357,9 @@ type ConnectionOptionsApple struct {
 	Scope              *string   `json:"scope,omitempty"`
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsApple) Scopes() []string {
@@ -363,6 +384,9 @@ type ConnectionOptionsLinkedin struct {
 
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsLinkedin) Scopes() []string {
@@ -402,6 +426,9 @@ type ConnectionOptionsGitHub struct {
 
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsGitHub) Scopes() []string {
@@ -424,6 +451,9 @@ type ConnectionOptionsEmail struct {
 	BruteForceProtection *bool     `json:"brute_force_protection,omitempty"`
 	SetUserAttributes    *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs   *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsEmailSettings struct {
@@ -431,11 +461,15 @@ type ConnectionOptionsEmailSettings struct {
 	From    *string `json:"from,omitempty"`
 	Subject *string `json:"subject,omitempty"`
 	Body    *string `json:"body,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsOTP struct {
 	TimeStep *int `json:"time_step,omitempty"`
 	Length   *int `json:"length,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionGatewayAuthentication struct {
@@ -444,6 +478,8 @@ type ConnectionGatewayAuthentication struct {
 	Audience            *string `json:"audience,omitempty"`
 	Secret              *string `json:"secret,omitempty"`
 	SecretBase64Encoded *bool   `json:"secret_base64_encoded,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsSMS struct {
@@ -467,6 +503,9 @@ type ConnectionOptionsSMS struct {
 
 	DisableSignup        *bool `json:"disable_signup,omitempty"`
 	BruteForceProtection *bool `json:"brute_force_protection,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsWindowsLive struct {
@@ -502,6 +541,9 @@ type ConnectionOptionsWindowsLive struct {
 
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsWindowsLive) Scopes() []string {
@@ -523,6 +565,9 @@ type ConnectionOptionsSalesforce struct {
 	CommunityBaseURL   *string   `json:"community_base_url,omitempty"`
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsSalesforce) Scopes() []string {
@@ -552,6 +597,9 @@ type ConnectionOptionsOIDC struct {
 
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsOIDC) Scopes() []string {
@@ -588,6 +636,8 @@ type ConnectionOptionsOAuth2 struct {
 	// Scripts for the connection
 	// Allowed keys are: "fetchUserProfile"
 	Scripts map[string]interface{} `json:"scripts,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsOAuth2) Scopes() []string {
@@ -626,6 +676,9 @@ type ConnectionOptionsAD struct {
 
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsAzureAD struct {
@@ -660,6 +713,9 @@ type ConnectionOptionsAzureAD struct {
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	TrustEmailVerified *string   `json:"should_trust_email_verified_connection,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsAzureAD) Scopes() []string {
@@ -681,6 +737,9 @@ type ConnectionOptionsADFS struct {
 	// Set to on_first_login to avoid setting user attributes at each login.
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsSAML struct {
@@ -710,6 +769,9 @@ type ConnectionOptionsSAML struct {
 
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsSAMLIdpInitiated struct {
@@ -720,11 +782,16 @@ type ConnectionOptionsSAMLIdpInitiated struct {
 
 	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
 	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsSAMLSigningKey struct {
 	Key  *string `json:"key,omitempty"`
 	Cert *string `json:"cert,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ConnectionOptionsGoogleApps struct {
@@ -746,6 +813,9 @@ type ConnectionOptionsGoogleApps struct {
 
 	DomainAliases []interface{} `json:"domain_aliases,omitempty"`
 	LogoURL       *string       `json:"icon_url,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *ConnectionOptionsGoogleApps) Scopes() []string {
@@ -765,6 +835,22 @@ type ConnectionList struct {
 	Connections []*Connection `json:"connections"`
 }
 
+// ConnectionIterator iterates over connections, fetching further pages as
+// needed.
+type ConnectionIterator struct {
+	it *iterator
+}
+
+// Next returns the next connection. It returns Done when there are no more
+// connections.
+func (i *ConnectionIterator) Next() (*Connection, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Connection), nil
+}
+
 func newConnectionManager(m *Management) *ConnectionManager {
 	return &ConnectionManager{m}
 }
@@ -773,14 +859,14 @@ func newConnectionManager(m *Management) *ConnectionManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Connections/post_connections
 func (m *ConnectionManager) Create(c *Connection, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("connections"), c, opts...)
+	return m.Request("POST", m.URI("connections"), c, withOperation("Connection.Create", opts)...)
 }
 
 // Read retrieves a connection by its id.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Connections/get_connections_by_id
 func (m *ConnectionManager) Read(id string, opts ...RequestOption) (c *Connection, err error) {
-	err = m.Request("GET", m.URI("connections", id), &c, opts...)
+	err = m.Request("GET", m.URI("connections", id), &c, withOperation("Connection.Read", opts)...)
 	return
 }
 
@@ -788,10 +874,18 @@ func (m *ConnectionManager) Read(id string, opts ...RequestOption) (c *Connectio
 //
 // See: https://auth0.com/docs/api/management/v2#!/Connections/get_connections
 func (m *ConnectionManager) List(opts ...RequestOption) (c *ConnectionList, err error) {
-	err = m.Request("GET", m.URI("connections"), &c, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("connections"), &c, applyListDefaults(withOperation("Connection.List", opts)))
 	return
 }
 
+// Iterate returns an iterator over all connections, fetching further pages as
+// the iteration advances.
+func (m *ConnectionManager) Iterate(opts ...RequestOption) *ConnectionIterator {
+	return &ConnectionIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
 // Update a connection.
 //
 // Note: if you use the options parameter, the whole options object will be
@@ -799,21 +893,25 @@ func (m *ConnectionManager) List(opts ...RequestOption) (c *ConnectionList, err
 //
 // See: https://auth0.com/docs/api/management/v2#!/Connections/patch_connections_by_id
 func (m *ConnectionManager) Update(id string, c *Connection, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("connections", id), c, opts...)
+	return m.Request("PATCH", m.URI("connections", id), c, withOperation("Connection.Update", opts)...)
 }
 
 // Delete a connection and all its users.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Connections/delete_connections_by_id
 func (m *ConnectionManager) Delete(id string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("connections", id), nil, opts...)
+	return m.Request("DELETE", m.URI("connections", id), nil, withOperation("Connection.Delete", opts)...)
 }
 
 // ReadByName retrieves a connection by its name. This is a helper method when a
 // connection id is not readily available.
 func (m *ConnectionManager) ReadByName(name string, opts ...RequestOption) (*Connection, error) {
 	if name == "" {
-		return nil, &managementError{400, "Bad Request", "Name cannot be empty"}
+		return nil, &APIError{
+			StatusCode: http.StatusBadRequest,
+			Err:        http.StatusText(http.StatusBadRequest),
+			Message:    "Name cannot be empty",
+		}
 	}
 	c, err := m.List(append(opts, Parameter("name", name))...)
 	if err != nil {
@@ -822,5 +920,9 @@ func (m *ConnectionManager) ReadByName(name string, opts ...RequestOption) (*Con
 	if len(c.Connections) > 0 {
 		return c.Connections[0], nil
 	}
-	return nil, &managementError{404, "Not Found", "Connection not found"}
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Err:        http.StatusText(http.StatusNotFound),
+		Message:    "Connection not found",
+	}
 }
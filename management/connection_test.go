@@ -3,7 +3,6 @@ package management
 import (
 	"log"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -14,7 +13,7 @@ import (
 func TestConnection(t *testing.T) {
 
 	c := &Connection{
-		Name:     auth0.Stringf("Test-Connection-%d", time.Now().Unix()),
+		Name:     auth0.Stringf("Test-Connection-%d", testTime().Unix()),
 		Strategy: auth0.String("auth0"),
 	}
 
@@ -140,7 +139,7 @@ func TestConnection(t *testing.T) {
 		if err == nil {
 			t.Fail()
 		}
-		mgmtError, ok := err.(*managementError)
+		mgmtError, ok := err.(*APIError)
 		if !ok {
 			t.Fail()
 		}
@@ -158,7 +157,7 @@ func TestConnectionOptions(t *testing.T) {
 
 	t.Run("GoogleOAuth2", func(t *testing.T) {
 		g := &Connection{
-			Name:     auth0.Stringf("Test-Connection-%d", time.Now().Unix()),
+			Name:     auth0.Stringf("Test-Connection-%d", testTime().Unix()),
 			Strategy: auth0.String("google-oauth2"),
 			Options: &ConnectionOptionsGoogleOAuth2{
 				AllowedAudiences: []interface{}{
@@ -225,7 +224,7 @@ func TestConnectionOptions(t *testing.T) {
 
 	t.Run("GoogleApps", func(t *testing.T) {
 		g := &Connection{
-			Name:     auth0.Stringf("Test-Connection-%d", time.Now().Unix()),
+			Name:     auth0.Stringf("Test-Connection-%d", testTime().Unix()),
 			Strategy: auth0.String("google-apps"),
 			Options: &ConnectionOptionsGoogleApps{
 				Domain:          auth0.String("example.com"),
@@ -334,7 +333,7 @@ func TestConnectionOptions(t *testing.T) {
 	t.Run("Email", func(t *testing.T) {
 
 		e := &Connection{
-			Name:     auth0.Stringf("Test-Connection-Email-%d", time.Now().Unix()),
+			Name:     auth0.Stringf("Test-Connection-Email-%d", testTime().Unix()),
 			Strategy: auth0.String("email"),
 			Options: &ConnectionOptionsEmail{
 				Email: &ConnectionOptionsEmailSettings{
@@ -398,7 +397,7 @@ func TestConnectionOptions(t *testing.T) {
 	t.Run("SMS", func(t *testing.T) {
 
 		s := &Connection{
-			Name:     auth0.Stringf("Test-Connection-SMS-%d", time.Now().Unix()),
+			Name:     auth0.Stringf("Test-Connection-SMS-%d", testTime().Unix()),
 			Strategy: auth0.String("sms"),
 			Options: &ConnectionOptionsSMS{
 				From:     auth0.String("+17777777777"),
@@ -454,7 +453,7 @@ func TestConnectionOptions(t *testing.T) {
 	t.Run("CustomSMS", func(t *testing.T) {
 
 		s := &Connection{
-			Name:     auth0.Stringf("Test-Connection-Custom-SMS-%d", time.Now().Unix()),
+			Name:     auth0.Stringf("Test-Connection-Custom-SMS-%d", testTime().Unix()),
 			Strategy: auth0.String("sms"),
 			Options: &ConnectionOptionsSMS{
 				From:     auth0.String("+17777777777"),
@@ -518,7 +517,7 @@ func TestConnectionOptions(t *testing.T) {
 	t.Run("SAML", func(t *testing.T) {
 
 		g := &Connection{
-			Name:     auth0.Stringf("Test-SAML-Connection-%d", time.Now().Unix()),
+			Name:     auth0.Stringf("Test-SAML-Connection-%d", testTime().Unix()),
 			Strategy: auth0.String("samlp"),
 			Options: &ConnectionOptionsSAML{
 				SignInEndpoint: auth0.String("https://saml.identity/provider"),
@@ -574,7 +573,7 @@ ZsUkLw2I7zI/dNlWdB8Xp7v+3w9sX5N3J/WuJ1KOO5m26kRlHQo7EzT3974g
 
 	t.Run("AD", func(t *testing.T) {
 		a := &Connection{
-			Name:     auth0.Stringf("Test-Connection-%d", time.Now().Unix()),
+			Name:     auth0.Stringf("Test-Connection-%d", testTime().Unix()),
 			Strategy: auth0.String("ad"),
 		}
 
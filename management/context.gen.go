@@ -0,0 +1,1000 @@
+// Code generated by gen-context; DO NOT EDIT.
+
+package management
+
+import "context"
+
+// BindingsContext is like Bindings, but sends its requests using ctx.
+func (m *ActionManager) BindingsContext(ctx context.Context, triggerID string, opts ...RequestOption) (*ActionBindingList, error) {
+	return m.Bindings(triggerID, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *ActionManager) CreateContext(ctx context.Context, a *Action, opts ...RequestOption) error {
+	return m.Create(a, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *ActionManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// DeployContext is like Deploy, but sends its requests using ctx.
+func (m *ActionManager) DeployContext(ctx context.Context, id string, opts ...RequestOption) (*ActionVersion, error) {
+	return m.Deploy(id, withContext(ctx, opts)...)
+}
+
+// DeployVersionContext is like DeployVersion, but sends its requests using ctx.
+func (m *ActionManager) DeployVersionContext(ctx context.Context, id string, versionId string, opts ...RequestOption) (*ActionVersion, error) {
+	return m.DeployVersion(id, versionId, withContext(ctx, opts)...)
+}
+
+// ExecutionContext is like Execution, but sends its requests using ctx.
+func (m *ActionManager) ExecutionContext(ctx context.Context, executionId string, opts ...RequestOption) (*ActionExecution, error) {
+	return m.Execution(executionId, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *ActionManager) IterateContext(ctx context.Context, opts ...RequestOption) *ActionIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// IterateBindingsContext is like IterateBindings, but sends its requests using ctx.
+func (m *ActionManager) IterateBindingsContext(ctx context.Context, triggerID string, opts ...RequestOption) *ActionBindingIterator {
+	return m.IterateBindings(triggerID, withContext(ctx, opts)...)
+}
+
+// IterateVersionsContext is like IterateVersions, but sends its requests using ctx.
+func (m *ActionManager) IterateVersionsContext(ctx context.Context, id string, opts ...RequestOption) *ActionVersionIterator {
+	return m.IterateVersions(id, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *ActionManager) ListContext(ctx context.Context, opts ...RequestOption) (*ActionList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ListBindingsContext is like ListBindings, but sends its requests using ctx.
+func (m *ActionManager) ListBindingsContext(ctx context.Context, triggerID string, opts ...RequestOption) (*ActionBindingList, error) {
+	return m.ListBindings(triggerID, withContext(ctx, opts)...)
+}
+
+// ListTriggersContext is like ListTriggers, but sends its requests using ctx.
+func (m *ActionManager) ListTriggersContext(ctx context.Context, opts ...RequestOption) (*ActionTriggerList, error) {
+	return m.ListTriggers(withContext(ctx, opts)...)
+}
+
+// ListVersionsContext is like ListVersions, but sends its requests using ctx.
+func (m *ActionManager) ListVersionsContext(ctx context.Context, id string, opts ...RequestOption) (*ActionVersionList, error) {
+	return m.ListVersions(id, withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *ActionManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Action, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// ReadExecutionContext is like ReadExecution, but sends its requests using ctx.
+func (m *ActionManager) ReadExecutionContext(ctx context.Context, executionId string, opts ...RequestOption) (*ActionExecution, error) {
+	return m.ReadExecution(executionId, withContext(ctx, opts)...)
+}
+
+// ReadVersionContext is like ReadVersion, but sends its requests using ctx.
+func (m *ActionManager) ReadVersionContext(ctx context.Context, id string, versionId string, opts ...RequestOption) (*ActionVersion, error) {
+	return m.ReadVersion(id, versionId, withContext(ctx, opts)...)
+}
+
+// TestContext is like Test, but sends its requests using ctx.
+func (m *ActionManager) TestContext(ctx context.Context, id string, payload *ActionTestPayload, opts ...RequestOption) error {
+	return m.Test(id, payload, withContext(ctx, opts)...)
+}
+
+// TriggersContext is like Triggers, but sends its requests using ctx.
+func (m *ActionManager) TriggersContext(ctx context.Context, opts ...RequestOption) (*ActionTriggerList, error) {
+	return m.Triggers(withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *ActionManager) UpdateContext(ctx context.Context, id string, a *Action, opts ...RequestOption) error {
+	return m.Update(id, a, withContext(ctx, opts)...)
+}
+
+// UpdateBindingsContext is like UpdateBindings, but sends its requests using ctx.
+func (m *ActionManager) UpdateBindingsContext(ctx context.Context, triggerID string, b []*ActionBinding, opts ...RequestOption) error {
+	return m.UpdateBindings(triggerID, b, withContext(ctx, opts)...)
+}
+
+// VersionContext is like Version, but sends its requests using ctx.
+func (m *ActionManager) VersionContext(ctx context.Context, id string, versionId string, opts ...RequestOption) (*ActionVersion, error) {
+	return m.Version(id, versionId, withContext(ctx, opts)...)
+}
+
+// VersionsContext is like Versions, but sends its requests using ctx.
+func (m *ActionManager) VersionsContext(ctx context.Context, id string, opts ...RequestOption) (*ActionVersionList, error) {
+	return m.Versions(id, withContext(ctx, opts)...)
+}
+
+// CheckIPContext is like CheckIP, but sends its requests using ctx.
+func (m *AnomalyManager) CheckIPContext(ctx context.Context, ip string, opts ...RequestOption) (bool, error) {
+	return m.CheckIP(ip, withContext(ctx, opts)...)
+}
+
+// UnblockIPContext is like UnblockIP, but sends its requests using ctx.
+func (m *AnomalyManager) UnblockIPContext(ctx context.Context, ip string, opts ...RequestOption) error {
+	return m.UnblockIP(ip, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *BlacklistManager) CreateContext(ctx context.Context, t *BlacklistToken, opts ...RequestOption) error {
+	return m.Create(t, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *BlacklistManager) ListContext(ctx context.Context, opts ...RequestOption) ([]*BlacklistToken, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// DeleteUniversalLoginContext is like DeleteUniversalLogin, but sends its requests using ctx.
+func (m *BrandingManager) DeleteUniversalLoginContext(ctx context.Context, opts ...RequestOption) error {
+	return m.DeleteUniversalLogin(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *BrandingManager) ReadContext(ctx context.Context, opts ...RequestOption) (*Branding, error) {
+	return m.Read(withContext(ctx, opts)...)
+}
+
+// SetUniversalLoginContext is like SetUniversalLogin, but sends its requests using ctx.
+func (m *BrandingManager) SetUniversalLoginContext(ctx context.Context, ul *BrandingUniversalLogin, opts ...RequestOption) error {
+	return m.SetUniversalLogin(ul, withContext(ctx, opts)...)
+}
+
+// UniversalLoginContext is like UniversalLogin, but sends its requests using ctx.
+func (m *BrandingManager) UniversalLoginContext(ctx context.Context, opts ...RequestOption) (*BrandingUniversalLogin, error) {
+	return m.UniversalLogin(withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *BrandingManager) UpdateContext(ctx context.Context, t *Branding, opts ...RequestOption) error {
+	return m.Update(t, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *ClientGrantManager) CreateContext(ctx context.Context, g *ClientGrant, opts ...RequestOption) error {
+	return m.Create(g, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *ClientGrantManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *ClientGrantManager) IterateContext(ctx context.Context, opts ...RequestOption) *ClientGrantIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *ClientGrantManager) ListContext(ctx context.Context, opts ...RequestOption) (*ClientGrantList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *ClientGrantManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*ClientGrant, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *ClientGrantManager) UpdateContext(ctx context.Context, id string, g *ClientGrant, opts ...RequestOption) error {
+	return m.Update(id, g, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *ClientManager) CreateContext(ctx context.Context, c *Client, opts ...RequestOption) error {
+	return m.Create(c, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *ClientManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *ClientManager) IterateContext(ctx context.Context, opts ...RequestOption) *ClientIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *ClientManager) ListContext(ctx context.Context, opts ...RequestOption) (*ClientList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *ClientManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Client, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// RotateSecretContext is like RotateSecret, but sends its requests using ctx.
+func (m *ClientManager) RotateSecretContext(ctx context.Context, id string, opts ...RequestOption) (*Client, error) {
+	return m.RotateSecret(id, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *ClientManager) UpdateContext(ctx context.Context, id string, c *Client, opts ...RequestOption) error {
+	return m.Update(id, c, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *ConnectionManager) CreateContext(ctx context.Context, c *Connection, opts ...RequestOption) error {
+	return m.Create(c, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *ConnectionManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *ConnectionManager) IterateContext(ctx context.Context, opts ...RequestOption) *ConnectionIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *ConnectionManager) ListContext(ctx context.Context, opts ...RequestOption) (*ConnectionList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *ConnectionManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Connection, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// ReadByNameContext is like ReadByName, but sends its requests using ctx.
+func (m *ConnectionManager) ReadByNameContext(ctx context.Context, name string, opts ...RequestOption) (*Connection, error) {
+	return m.ReadByName(name, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *ConnectionManager) UpdateContext(ctx context.Context, id string, c *Connection, opts ...RequestOption) error {
+	return m.Update(id, c, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *CustomDomainManager) CreateContext(ctx context.Context, c *CustomDomain, opts ...RequestOption) error {
+	return m.Create(c, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *CustomDomainManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *CustomDomainManager) ListContext(ctx context.Context, opts ...RequestOption) ([]*CustomDomain, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *CustomDomainManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*CustomDomain, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *CustomDomainManager) UpdateContext(ctx context.Context, id string, c *CustomDomain, opts ...RequestOption) error {
+	return m.Update(id, c, withContext(ctx, opts)...)
+}
+
+// VerifyContext is like Verify, but sends its requests using ctx.
+func (m *CustomDomainManager) VerifyContext(ctx context.Context, id string, opts ...RequestOption) (*CustomDomain, error) {
+	return m.Verify(id, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *EmailManager) CreateContext(ctx context.Context, e *Email, opts ...RequestOption) error {
+	return m.Create(e, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *EmailManager) DeleteContext(ctx context.Context, opts ...RequestOption) error {
+	return m.Delete(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *EmailManager) ReadContext(ctx context.Context, opts ...RequestOption) (*Email, error) {
+	return m.Read(withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *EmailManager) UpdateContext(ctx context.Context, e *Email, opts ...RequestOption) error {
+	return m.Update(e, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *EmailTemplateManager) CreateContext(ctx context.Context, e *EmailTemplate, opts ...RequestOption) error {
+	return m.Create(e, withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *EmailTemplateManager) ReadContext(ctx context.Context, template string, opts ...RequestOption) (*EmailTemplate, error) {
+	return m.Read(template, withContext(ctx, opts)...)
+}
+
+// ReplaceContext is like Replace, but sends its requests using ctx.
+func (m *EmailTemplateManager) ReplaceContext(ctx context.Context, template string, e *EmailTemplate, opts ...RequestOption) error {
+	return m.Replace(template, e, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *EmailTemplateManager) UpdateContext(ctx context.Context, template string, e *EmailTemplate, opts ...RequestOption) error {
+	return m.Update(template, e, withContext(ctx, opts)...)
+}
+
+// CreateTicketContext is like CreateTicket, but sends its requests using ctx.
+func (m *EnrollmentManager) CreateTicketContext(ctx context.Context, t *CreateEnrollmentTicket, opts ...RequestOption) (EnrollmentTicket, error) {
+	return m.CreateTicket(t, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *EnrollmentManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// GetContext is like Get, but sends its requests using ctx.
+func (m *EnrollmentManager) GetContext(ctx context.Context, id string, opts ...RequestOption) (*Enrollment, error) {
+	return m.Get(id, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *GrantManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *GrantManager) IterateContext(ctx context.Context, opts ...RequestOption) *GrantIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *GrantManager) ListContext(ctx context.Context, opts ...RequestOption) (*GrantList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *HookManager) CreateContext(ctx context.Context, h *Hook, opts ...RequestOption) error {
+	return m.Create(h, withContext(ctx, opts)...)
+}
+
+// CreateSecretsContext is like CreateSecrets, but sends its requests using ctx.
+func (m *HookManager) CreateSecretsContext(ctx context.Context, hookID string, s HookSecrets, opts ...RequestOption) error {
+	return m.CreateSecrets(hookID, s, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *HookManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *HookManager) IterateContext(ctx context.Context, opts ...RequestOption) *HookIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *HookManager) ListContext(ctx context.Context, opts ...RequestOption) (*HookList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *HookManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Hook, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// RemoveAllSecretsContext is like RemoveAllSecrets, but sends its requests using ctx.
+func (m *HookManager) RemoveAllSecretsContext(ctx context.Context, hookID string, opts ...RequestOption) error {
+	return m.RemoveAllSecrets(hookID, withContext(ctx, opts)...)
+}
+
+// RemoveSecretsContext is like RemoveSecrets, but sends its requests using ctx.
+func (m *HookManager) RemoveSecretsContext(ctx context.Context, hookID string, keys []string, opts ...RequestOption) error {
+	return m.RemoveSecrets(hookID, keys, withContext(ctx, opts)...)
+}
+
+// ReplaceSecretsContext is like ReplaceSecrets, but sends its requests using ctx.
+func (m *HookManager) ReplaceSecretsContext(ctx context.Context, hookID string, s HookSecrets, opts ...RequestOption) error {
+	return m.ReplaceSecrets(hookID, s, withContext(ctx, opts)...)
+}
+
+// SecretsContext is like Secrets, but sends its requests using ctx.
+func (m *HookManager) SecretsContext(ctx context.Context, hookID string, opts ...RequestOption) (HookSecrets, error) {
+	return m.Secrets(hookID, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *HookManager) UpdateContext(ctx context.Context, id string, h *Hook, opts ...RequestOption) error {
+	return m.Update(id, h, withContext(ctx, opts)...)
+}
+
+// UpdateSecretsContext is like UpdateSecrets, but sends its requests using ctx.
+func (m *HookManager) UpdateSecretsContext(ctx context.Context, hookID string, s HookSecrets, opts ...RequestOption) error {
+	return m.UpdateSecrets(hookID, s, withContext(ctx, opts)...)
+}
+
+// DownloadUsersContext is like DownloadUsers, but sends its requests using ctx.
+func (m *JobManager) DownloadUsersContext(ctx context.Context, j *Job, opts ...RequestOption) (*UserExport, error) {
+	return m.DownloadUsers(j, withContext(ctx, opts)...)
+}
+
+// ErrorsContext is like Errors, but sends its requests using ctx.
+func (m *JobManager) ErrorsContext(ctx context.Context, id string, opts ...RequestOption) ([]*JobError, error) {
+	return m.Errors(id, withContext(ctx, opts)...)
+}
+
+// ExportUsersContext is like ExportUsers, but sends its requests using ctx.
+func (m *JobManager) ExportUsersContext(ctx context.Context, j *Job, opts ...RequestOption) error {
+	return m.ExportUsers(j, withContext(ctx, opts)...)
+}
+
+// ImportUsersContext is like ImportUsers, but sends its requests using ctx.
+func (m *JobManager) ImportUsersContext(ctx context.Context, j *Job, opts ...RequestOption) error {
+	return m.ImportUsers(j, withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *JobManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Job, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// VerifyEmailContext is like VerifyEmail, but sends its requests using ctx.
+func (m *JobManager) VerifyEmailContext(ctx context.Context, j *Job, opts ...RequestOption) error {
+	return m.VerifyEmail(j, withContext(ctx, opts)...)
+}
+
+// WaitContext is like Wait, but sends its requests using ctx.
+func (m *JobManager) WaitContext(ctx context.Context, j *Job, progress func(j *Job), opts ...RequestOption) error {
+	return m.Wait(j, progress, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *LogManager) IterateContext(ctx context.Context, opts ...RequestOption) *LogIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *LogManager) ListContext(ctx context.Context, opts ...RequestOption) ([]*Log, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *LogManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Log, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// SearchContext is like Search, but sends its requests using ctx.
+func (m *LogManager) SearchContext(ctx context.Context, opts ...RequestOption) ([]*Log, error) {
+	return m.Search(withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *LogStreamManager) CreateContext(ctx context.Context, l *LogStream, opts ...RequestOption) error {
+	return m.Create(l, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *LogStreamManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *LogStreamManager) ListContext(ctx context.Context, opts ...RequestOption) ([]*LogStream, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *LogStreamManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*LogStream, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *LogStreamManager) UpdateContext(ctx context.Context, id string, l *LogStream, opts ...RequestOption) error {
+	return m.Update(id, l, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *MultiFactorManager) ListContext(ctx context.Context, opts ...RequestOption) ([]*MultiFactor, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// PolicyContext is like Policy, but sends its requests using ctx.
+func (m *MultiFactorManager) PolicyContext(ctx context.Context, opts ...RequestOption) (*MultiFactorPolicies, error) {
+	return m.Policy(withContext(ctx, opts)...)
+}
+
+// UpdatePolicyContext is like UpdatePolicy, but sends its requests using ctx.
+func (m *MultiFactorManager) UpdatePolicyContext(ctx context.Context, p *MultiFactorPolicies, opts ...RequestOption) error {
+	return m.UpdatePolicy(p, withContext(ctx, opts)...)
+}
+
+// AddConnectionContext is like AddConnection, but sends its requests using ctx.
+func (m *OrganizationManager) AddConnectionContext(ctx context.Context, id string, c *OrganizationConnection, opts ...RequestOption) error {
+	return m.AddConnection(id, c, withContext(ctx, opts)...)
+}
+
+// AddMembersContext is like AddMembers, but sends its requests using ctx.
+func (m *OrganizationManager) AddMembersContext(ctx context.Context, id string, memberIDs []string, opts ...RequestOption) error {
+	return m.AddMembers(id, memberIDs, withContext(ctx, opts)...)
+}
+
+// AssignMemberRolesContext is like AssignMemberRoles, but sends its requests using ctx.
+func (m *OrganizationManager) AssignMemberRolesContext(ctx context.Context, id string, memberID string, roles []string, opts ...RequestOption) error {
+	return m.AssignMemberRoles(id, memberID, roles, withContext(ctx, opts)...)
+}
+
+// ConnectionContext is like Connection, but sends its requests using ctx.
+func (m *OrganizationManager) ConnectionContext(ctx context.Context, id string, connectionID string, opts ...RequestOption) (*OrganizationConnection, error) {
+	return m.Connection(id, connectionID, withContext(ctx, opts)...)
+}
+
+// ConnectionsContext is like Connections, but sends its requests using ctx.
+func (m *OrganizationManager) ConnectionsContext(ctx context.Context, id string, opts ...RequestOption) (*OrganizationConnectionList, error) {
+	return m.Connections(id, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *OrganizationManager) CreateContext(ctx context.Context, o *Organization, opts ...RequestOption) error {
+	return m.Create(o, withContext(ctx, opts)...)
+}
+
+// CreateInvitationContext is like CreateInvitation, but sends its requests using ctx.
+func (m *OrganizationManager) CreateInvitationContext(ctx context.Context, id string, i *OrganizationInvitation, opts ...RequestOption) error {
+	return m.CreateInvitation(id, i, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *OrganizationManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// DeleteConnectionContext is like DeleteConnection, but sends its requests using ctx.
+func (m *OrganizationManager) DeleteConnectionContext(ctx context.Context, id string, connectionID string, opts ...RequestOption) error {
+	return m.DeleteConnection(id, connectionID, withContext(ctx, opts)...)
+}
+
+// DeleteInvitationContext is like DeleteInvitation, but sends its requests using ctx.
+func (m *OrganizationManager) DeleteInvitationContext(ctx context.Context, id string, invitationID string, opts ...RequestOption) error {
+	return m.DeleteInvitation(id, invitationID, withContext(ctx, opts)...)
+}
+
+// DeleteMemberContext is like DeleteMember, but sends its requests using ctx.
+func (m *OrganizationManager) DeleteMemberContext(ctx context.Context, id string, memberIDs []string, opts ...RequestOption) error {
+	return m.DeleteMember(id, memberIDs, withContext(ctx, opts)...)
+}
+
+// DeleteMemberRolesContext is like DeleteMemberRoles, but sends its requests using ctx.
+func (m *OrganizationManager) DeleteMemberRolesContext(ctx context.Context, id string, memberID string, roles []string, opts ...RequestOption) error {
+	return m.DeleteMemberRoles(id, memberID, roles, withContext(ctx, opts)...)
+}
+
+// InvitationContext is like Invitation, but sends its requests using ctx.
+func (m *OrganizationManager) InvitationContext(ctx context.Context, id string, invitationID string, opts ...RequestOption) (*OrganizationInvitation, error) {
+	return m.Invitation(id, invitationID, withContext(ctx, opts)...)
+}
+
+// InvitationsContext is like Invitations, but sends its requests using ctx.
+func (m *OrganizationManager) InvitationsContext(ctx context.Context, id string, opts ...RequestOption) (*OrganizationInvitationList, error) {
+	return m.Invitations(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *OrganizationManager) IterateContext(ctx context.Context, opts ...RequestOption) *OrganizationIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// IterateConnectionsContext is like IterateConnections, but sends its requests using ctx.
+func (m *OrganizationManager) IterateConnectionsContext(ctx context.Context, id string, opts ...RequestOption) *OrganizationConnectionIterator {
+	return m.IterateConnections(id, withContext(ctx, opts)...)
+}
+
+// IterateInvitationsContext is like IterateInvitations, but sends its requests using ctx.
+func (m *OrganizationManager) IterateInvitationsContext(ctx context.Context, id string, opts ...RequestOption) *OrganizationInvitationIterator {
+	return m.IterateInvitations(id, withContext(ctx, opts)...)
+}
+
+// IterateMemberRolesContext is like IterateMemberRoles, but sends its requests using ctx.
+func (m *OrganizationManager) IterateMemberRolesContext(ctx context.Context, id string, memberID string, opts ...RequestOption) *OrganizationMemberRoleIterator {
+	return m.IterateMemberRoles(id, memberID, withContext(ctx, opts)...)
+}
+
+// IterateMembersContext is like IterateMembers, but sends its requests using ctx.
+func (m *OrganizationManager) IterateMembersContext(ctx context.Context, id string, opts ...RequestOption) *OrganizationMemberIterator {
+	return m.IterateMembers(id, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *OrganizationManager) ListContext(ctx context.Context, opts ...RequestOption) (*OrganizationList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// MemberRolesContext is like MemberRoles, but sends its requests using ctx.
+func (m *OrganizationManager) MemberRolesContext(ctx context.Context, id string, memberID string, opts ...RequestOption) (*OrganizationMemberRoleList, error) {
+	return m.MemberRoles(id, memberID, withContext(ctx, opts)...)
+}
+
+// MembersContext is like Members, but sends its requests using ctx.
+func (m *OrganizationManager) MembersContext(ctx context.Context, id string, opts ...RequestOption) (*OrganizationMemberList, error) {
+	return m.Members(id, withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *OrganizationManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Organization, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// ReadByNameContext is like ReadByName, but sends its requests using ctx.
+func (m *OrganizationManager) ReadByNameContext(ctx context.Context, name string, opts ...RequestOption) (*Organization, error) {
+	return m.ReadByName(name, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *OrganizationManager) UpdateContext(ctx context.Context, id string, o *Organization, opts ...RequestOption) error {
+	return m.Update(id, o, withContext(ctx, opts)...)
+}
+
+// UpdateConnectionContext is like UpdateConnection, but sends its requests using ctx.
+func (m *OrganizationManager) UpdateConnectionContext(ctx context.Context, id string, connectionID string, c *OrganizationConnection, opts ...RequestOption) error {
+	return m.UpdateConnection(id, connectionID, c, withContext(ctx, opts)...)
+}
+
+// CustomTextContext is like CustomText, but sends its requests using ctx.
+func (m *PromptManager) CustomTextContext(ctx context.Context, p string, l string, opts ...RequestOption) (map[string]interface{}, error) {
+	return m.CustomText(p, l, withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *PromptManager) ReadContext(ctx context.Context, opts ...RequestOption) (*Prompt, error) {
+	return m.Read(withContext(ctx, opts)...)
+}
+
+// SetCustomTextContext is like SetCustomText, but sends its requests using ctx.
+func (m *PromptManager) SetCustomTextContext(ctx context.Context, p string, l string, b map[string]interface{}, opts ...RequestOption) error {
+	return m.SetCustomText(p, l, b, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *PromptManager) UpdateContext(ctx context.Context, p *Prompt, opts ...RequestOption) error {
+	return m.Update(p, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *ResourceServerManager) CreateContext(ctx context.Context, rs *ResourceServer, opts ...RequestOption) error {
+	return m.Create(rs, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *ResourceServerManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *ResourceServerManager) IterateContext(ctx context.Context, opts ...RequestOption) *ResourceServerIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *ResourceServerManager) ListContext(ctx context.Context, opts ...RequestOption) (*ResourceServerList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *ResourceServerManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*ResourceServer, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// StreamContext is like Stream, but sends its requests using ctx.
+func (m *ResourceServerManager) StreamContext(ctx context.Context, fn func(s *ResourceServer), opts ...RequestOption) error {
+	return m.Stream(fn, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *ResourceServerManager) UpdateContext(ctx context.Context, id string, rs *ResourceServer, opts ...RequestOption) error {
+	return m.Update(id, rs, withContext(ctx, opts)...)
+}
+
+// AssignUsersContext is like AssignUsers, but sends its requests using ctx.
+func (m *RoleManager) AssignUsersContext(ctx context.Context, id string, users []*User, opts ...RequestOption) error {
+	return m.AssignUsers(id, users, withContext(ctx, opts)...)
+}
+
+// AssociatePermissionsContext is like AssociatePermissions, but sends its requests using ctx.
+func (m *RoleManager) AssociatePermissionsContext(ctx context.Context, id string, permissions []*Permission, opts ...RequestOption) error {
+	return m.AssociatePermissions(id, permissions, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *RoleManager) CreateContext(ctx context.Context, r *Role, opts ...RequestOption) error {
+	return m.Create(r, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *RoleManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *RoleManager) IterateContext(ctx context.Context, opts ...RequestOption) *RoleIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// IteratePermissionsContext is like IteratePermissions, but sends its requests using ctx.
+func (m *RoleManager) IteratePermissionsContext(ctx context.Context, id string, opts ...RequestOption) *PermissionIterator {
+	return m.IteratePermissions(id, withContext(ctx, opts)...)
+}
+
+// IterateUsersContext is like IterateUsers, but sends its requests using ctx.
+func (m *RoleManager) IterateUsersContext(ctx context.Context, id string, opts ...RequestOption) *UserIterator {
+	return m.IterateUsers(id, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *RoleManager) ListContext(ctx context.Context, opts ...RequestOption) (*RoleList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// PermissionsContext is like Permissions, but sends its requests using ctx.
+func (m *RoleManager) PermissionsContext(ctx context.Context, id string, opts ...RequestOption) (*PermissionList, error) {
+	return m.Permissions(id, withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *RoleManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Role, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// RemovePermissionsContext is like RemovePermissions, but sends its requests using ctx.
+func (m *RoleManager) RemovePermissionsContext(ctx context.Context, id string, permissions []*Permission, opts ...RequestOption) error {
+	return m.RemovePermissions(id, permissions, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *RoleManager) UpdateContext(ctx context.Context, id string, r *Role, opts ...RequestOption) error {
+	return m.Update(id, r, withContext(ctx, opts)...)
+}
+
+// UsersContext is like Users, but sends its requests using ctx.
+func (m *RoleManager) UsersContext(ctx context.Context, id string, opts ...RequestOption) (*UserList, error) {
+	return m.Users(id, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *RuleConfigManager) DeleteContext(ctx context.Context, key string, opts ...RequestOption) error {
+	return m.Delete(key, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *RuleConfigManager) ListContext(ctx context.Context, opts ...RequestOption) ([]*RuleConfig, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *RuleConfigManager) ReadContext(ctx context.Context, key string, opts ...RequestOption) (*RuleConfig, error) {
+	return m.Read(key, withContext(ctx, opts)...)
+}
+
+// UpsertContext is like Upsert, but sends its requests using ctx.
+func (m *RuleConfigManager) UpsertContext(ctx context.Context, key string, r *RuleConfig, opts ...RequestOption) error {
+	return m.Upsert(key, r, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *RuleManager) CreateContext(ctx context.Context, r *Rule, opts ...RequestOption) error {
+	return m.Create(r, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *RuleManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *RuleManager) IterateContext(ctx context.Context, opts ...RequestOption) *RuleIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *RuleManager) ListContext(ctx context.Context, opts ...RequestOption) (*RuleList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *RuleManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*Rule, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *RuleManager) UpdateContext(ctx context.Context, id string, r *Rule, opts ...RequestOption) error {
+	return m.Update(id, r, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *SigningKeyManager) ListContext(ctx context.Context, opts ...RequestOption) ([]*SigningKey, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *SigningKeyManager) ReadContext(ctx context.Context, kid string, opts ...RequestOption) (*SigningKey, error) {
+	return m.Read(kid, withContext(ctx, opts)...)
+}
+
+// RevokeContext is like Revoke, but sends its requests using ctx.
+func (m *SigningKeyManager) RevokeContext(ctx context.Context, kid string, opts ...RequestOption) (*SigningKey, error) {
+	return m.Revoke(kid, withContext(ctx, opts)...)
+}
+
+// RotateContext is like Rotate, but sends its requests using ctx.
+func (m *SigningKeyManager) RotateContext(ctx context.Context, opts ...RequestOption) (*SigningKey, error) {
+	return m.Rotate(withContext(ctx, opts)...)
+}
+
+// ActiveUsersContext is like ActiveUsers, but sends its requests using ctx.
+func (m *StatManager) ActiveUsersContext(ctx context.Context, opts ...RequestOption) (int, error) {
+	return m.ActiveUsers(withContext(ctx, opts)...)
+}
+
+// DailyContext is like Daily, but sends its requests using ctx.
+func (m *StatManager) DailyContext(ctx context.Context, opts ...RequestOption) ([]*DailyStat, error) {
+	return m.Daily(withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *TenantManager) ReadContext(ctx context.Context, opts ...RequestOption) (*Tenant, error) {
+	return m.Read(withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *TenantManager) UpdateContext(ctx context.Context, t *Tenant, opts ...RequestOption) error {
+	return m.Update(t, withContext(ctx, opts)...)
+}
+
+// ChangePasswordContext is like ChangePassword, but sends its requests using ctx.
+func (m *TicketManager) ChangePasswordContext(ctx context.Context, t *Ticket, opts ...RequestOption) error {
+	return m.ChangePassword(t, withContext(ctx, opts)...)
+}
+
+// VerifyEmailContext is like VerifyEmail, but sends its requests using ctx.
+func (m *TicketManager) VerifyEmailContext(ctx context.Context, t *Ticket, opts ...RequestOption) error {
+	return m.VerifyEmail(t, withContext(ctx, opts)...)
+}
+
+// AssignPermissionsContext is like AssignPermissions, but sends its requests using ctx.
+func (m *UserManager) AssignPermissionsContext(ctx context.Context, id string, permissions []*Permission, opts ...RequestOption) error {
+	return m.AssignPermissions(id, permissions, withContext(ctx, opts)...)
+}
+
+// AssignRolesContext is like AssignRoles, but sends its requests using ctx.
+func (m *UserManager) AssignRolesContext(ctx context.Context, id string, roles []*Role, opts ...RequestOption) error {
+	return m.AssignRoles(id, roles, withContext(ctx, opts)...)
+}
+
+// BlocksContext is like Blocks, but sends its requests using ctx.
+func (m *UserManager) BlocksContext(ctx context.Context, id string, opts ...RequestOption) ([]*UserBlock, error) {
+	return m.Blocks(id, withContext(ctx, opts)...)
+}
+
+// BlocksByIdentifierContext is like BlocksByIdentifier, but sends its requests using ctx.
+func (m *UserManager) BlocksByIdentifierContext(ctx context.Context, identifier string, opts ...RequestOption) ([]*UserBlock, error) {
+	return m.BlocksByIdentifier(identifier, withContext(ctx, opts)...)
+}
+
+// CreateContext is like Create, but sends its requests using ctx.
+func (m *UserManager) CreateContext(ctx context.Context, u *User, opts ...RequestOption) error {
+	return m.Create(u, withContext(ctx, opts)...)
+}
+
+// DeleteContext is like Delete, but sends its requests using ctx.
+func (m *UserManager) DeleteContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Delete(id, withContext(ctx, opts)...)
+}
+
+// EnrollmentsContext is like Enrollments, but sends its requests using ctx.
+func (m *UserManager) EnrollmentsContext(ctx context.Context, id string, opts ...RequestOption) ([]*UserEnrollment, error) {
+	return m.Enrollments(id, withContext(ctx, opts)...)
+}
+
+// InvalidateRememberBrowserContext is like InvalidateRememberBrowser, but sends its requests using ctx.
+func (m *UserManager) InvalidateRememberBrowserContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.InvalidateRememberBrowser(id, withContext(ctx, opts)...)
+}
+
+// IterateContext is like Iterate, but sends its requests using ctx.
+func (m *UserManager) IterateContext(ctx context.Context, opts ...RequestOption) *UserIterator {
+	return m.Iterate(withContext(ctx, opts)...)
+}
+
+// IterateOrganizationsContext is like IterateOrganizations, but sends its requests using ctx.
+func (m *UserManager) IterateOrganizationsContext(ctx context.Context, id string, opts ...RequestOption) *OrganizationIterator {
+	return m.IterateOrganizations(id, withContext(ctx, opts)...)
+}
+
+// IteratePermissionsContext is like IteratePermissions, but sends its requests using ctx.
+func (m *UserManager) IteratePermissionsContext(ctx context.Context, id string, opts ...RequestOption) *PermissionIterator {
+	return m.IteratePermissions(id, withContext(ctx, opts)...)
+}
+
+// IterateRolesContext is like IterateRoles, but sends its requests using ctx.
+func (m *UserManager) IterateRolesContext(ctx context.Context, id string, opts ...RequestOption) *RoleIterator {
+	return m.IterateRoles(id, withContext(ctx, opts)...)
+}
+
+// LinkContext is like Link, but sends its requests using ctx.
+func (m *UserManager) LinkContext(ctx context.Context, id string, il *UserIdentityLink, opts ...RequestOption) ([]UserIdentity, error) {
+	return m.Link(id, il, withContext(ctx, opts)...)
+}
+
+// ListContext is like List, but sends its requests using ctx.
+func (m *UserManager) ListContext(ctx context.Context, opts ...RequestOption) (*UserList, error) {
+	return m.List(withContext(ctx, opts)...)
+}
+
+// ListByEmailContext is like ListByEmail, but sends its requests using ctx.
+func (m *UserManager) ListByEmailContext(ctx context.Context, email string, opts ...RequestOption) ([]*User, error) {
+	return m.ListByEmail(email, withContext(ctx, opts)...)
+}
+
+// OrganizationsContext is like Organizations, but sends its requests using ctx.
+func (m *UserManager) OrganizationsContext(ctx context.Context, id string, opts ...RequestOption) (*OrganizationList, error) {
+	return m.Organizations(id, withContext(ctx, opts)...)
+}
+
+// PermissionsContext is like Permissions, but sends its requests using ctx.
+func (m *UserManager) PermissionsContext(ctx context.Context, id string, opts ...RequestOption) (*PermissionList, error) {
+	return m.Permissions(id, withContext(ctx, opts)...)
+}
+
+// ReadContext is like Read, but sends its requests using ctx.
+func (m *UserManager) ReadContext(ctx context.Context, id string, opts ...RequestOption) (*User, error) {
+	return m.Read(id, withContext(ctx, opts)...)
+}
+
+// RegenerateRecoveryCodeContext is like RegenerateRecoveryCode, but sends its requests using ctx.
+func (m *UserManager) RegenerateRecoveryCodeContext(ctx context.Context, id string, opts ...RequestOption) (*UserRecoveryCode, error) {
+	return m.RegenerateRecoveryCode(id, withContext(ctx, opts)...)
+}
+
+// RemovePermissionsContext is like RemovePermissions, but sends its requests using ctx.
+func (m *UserManager) RemovePermissionsContext(ctx context.Context, id string, permissions []*Permission, opts ...RequestOption) error {
+	return m.RemovePermissions(id, permissions, withContext(ctx, opts)...)
+}
+
+// RemoveRolesContext is like RemoveRoles, but sends its requests using ctx.
+func (m *UserManager) RemoveRolesContext(ctx context.Context, id string, roles []*Role, opts ...RequestOption) error {
+	return m.RemoveRoles(id, roles, withContext(ctx, opts)...)
+}
+
+// RolesContext is like Roles, but sends its requests using ctx.
+func (m *UserManager) RolesContext(ctx context.Context, id string, opts ...RequestOption) (*RoleList, error) {
+	return m.Roles(id, withContext(ctx, opts)...)
+}
+
+// SearchContext is like Search, but sends its requests using ctx.
+func (m *UserManager) SearchContext(ctx context.Context, opts ...RequestOption) (*UserList, error) {
+	return m.Search(withContext(ctx, opts)...)
+}
+
+// SearchAllContext is like SearchAll, but sends its requests using ctx.
+func (m *UserManager) SearchAllContext(ctx context.Context, opts ...RequestOption) *UserIterator {
+	return m.SearchAll(withContext(ctx, opts)...)
+}
+
+// UnblockContext is like Unblock, but sends its requests using ctx.
+func (m *UserManager) UnblockContext(ctx context.Context, id string, opts ...RequestOption) error {
+	return m.Unblock(id, withContext(ctx, opts)...)
+}
+
+// UnblockByIdentifierContext is like UnblockByIdentifier, but sends its requests using ctx.
+func (m *UserManager) UnblockByIdentifierContext(ctx context.Context, identifier string, opts ...RequestOption) error {
+	return m.UnblockByIdentifier(identifier, withContext(ctx, opts)...)
+}
+
+// UpdateContext is like Update, but sends its requests using ctx.
+func (m *UserManager) UpdateContext(ctx context.Context, id string, u *User, opts ...RequestOption) error {
+	return m.Update(id, u, withContext(ctx, opts)...)
+}
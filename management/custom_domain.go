@@ -30,6 +30,9 @@ type CustomDomain struct {
 
 	// The HTTP header to fetch the client's IP address
 	CustomClientIPHeader *string `json:"custom_client_ip_header,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type CustomDomainVerification struct {
@@ -53,21 +56,21 @@ func newCustomDomainManager(m *Management) *CustomDomainManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Custom_Domains/post_custom_domains
 func (m *CustomDomainManager) Create(c *CustomDomain, opts ...RequestOption) (err error) {
-	return m.Request("POST", m.URI("custom-domains"), c, opts...)
+	return m.Request("POST", m.URI("custom-domains"), c, withOperation("CustomDomain.Create", opts)...)
 }
 
 // Update a custom domain.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Custom_Domains/patch_custom_domains_by_id
 func (m *CustomDomainManager) Update(id string, c *CustomDomain, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("custom-domains", id), c, opts...)
+	return m.Request("PATCH", m.URI("custom-domains", id), c, withOperation("CustomDomain.Update", opts)...)
 }
 
 // Retrieve a custom domain configuration and status.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Custom_Domains/get_custom_domains_by_id
 func (m *CustomDomainManager) Read(id string, opts ...RequestOption) (c *CustomDomain, err error) {
-	err = m.Request("GET", m.URI("custom-domains", id), &c, opts...)
+	err = m.Request("GET", m.URI("custom-domains", id), &c, withOperation("CustomDomain.Read", opts)...)
 	return
 }
 
@@ -75,7 +78,7 @@ func (m *CustomDomainManager) Read(id string, opts ...RequestOption) (c *CustomD
 //
 // See: https://auth0.com/docs/api/management/v2#!/Custom_Domains/post_verify
 func (m *CustomDomainManager) Verify(id string, opts ...RequestOption) (c *CustomDomain, err error) {
-	err = m.Request("POST", m.URI("custom-domains", id, "verify"), &c, opts...)
+	err = m.Request("POST", m.URI("custom-domains", id, "verify"), &c, withOperation("CustomDomain.Verify", opts)...)
 	return
 }
 
@@ -83,13 +86,13 @@ func (m *CustomDomainManager) Verify(id string, opts ...RequestOption) (c *Custo
 //
 // See: https://auth0.com/docs/api/management/v2#!/Custom_Domains/delete_custom_domains_by_id
 func (m *CustomDomainManager) Delete(id string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("custom-domains", id), nil, opts...)
+	return m.Request("DELETE", m.URI("custom-domains", id), nil, withOperation("CustomDomain.Delete", opts)...)
 }
 
 // List all custom domains.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Custom_Domains/get_custom_domains
 func (m *CustomDomainManager) List(opts ...RequestOption) (c []*CustomDomain, err error) {
-	err = m.Request("GET", m.URI("custom-domains"), &c, opts...)
+	err = m.Request("GET", m.URI("custom-domains"), &c, withOperation("CustomDomain.List", opts)...)
 	return
 }
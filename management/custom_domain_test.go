@@ -3,7 +3,6 @@ package management
 import (
 	"net/http"
 	"testing"
-	"time"
 
 	"gopkg.in/auth0.v5"
 )
@@ -11,7 +10,7 @@ import (
 func TestCustomDomain(t *testing.T) {
 
 	c := &CustomDomain{
-		Domain:               auth0.Stringf("%d.auth.uat.alexkappa.com", time.Now().UTC().Unix()),
+		Domain:               auth0.Stringf("%d.auth.uat.alexkappa.com", testTime().UTC().Unix()),
 		Type:                 auth0.String("auth0_managed_certs"),
 		VerificationMethod:   auth0.String("txt"),
 		TLSPolicy:            auth0.String("recommended"),
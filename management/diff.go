@@ -0,0 +1,218 @@
+package management
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Change describes a difference between two values of a resource, as
+// returned by their Diff method. Resources are the types holding the
+// properties unknown to the SDK in an Extra field, such as Client, which also
+// have Clone and Equal methods.
+type Change struct {
+	// Path of the property which differs, made of the JSON property names
+	// leading to it, such as "jwt_configuration.alg", and of indexes for
+	// elements of lists, such as "callbacks[1]".
+	Path string
+
+	// Old and New are the values of the property, or nil if it is not set.
+	Old interface{}
+	New interface{}
+}
+
+// String returns a string representation of c.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Path, c.Old, c.New)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// clone returns a deep copy of v.
+func clone(v interface{}) interface{} {
+	return cloneValue(reflect.ValueOf(v)).Interface()
+}
+
+// diff returns the differences between a and b, which are of the same type.
+//
+// Nil and empty lists or maps are considered equal, as they are marshaled the
+// same way.
+func diff(a, b interface{}) []Change {
+	return diffValue(nil, "", reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(cloneValue(v.Field(i)))
+			}
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			c.SetMapIndex(k, cloneValue(v.MapIndex(k)))
+		}
+		return c
+	}
+	return v
+}
+
+// diffValue appends the differences between a and b, found at path, to
+// changes.
+func diffValue(changes []Change, path string, a, b reflect.Value) []Change {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			changes = append(changes, Change{path, value(a), value(b)})
+		}
+		return changes
+	}
+	if a.Type() != b.Type() {
+		return append(changes, Change{path, value(a), value(b)})
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				changes = append(changes, Change{path, value(a), value(b)})
+			}
+			return changes
+		}
+		return diffValue(changes, path, a.Elem(), b.Elem())
+
+	case reflect.Struct:
+		if a.Type() == timeType {
+			if !a.Interface().(time.Time).Equal(b.Interface().(time.Time)) {
+				changes = append(changes, Change{path, value(a), value(b)})
+			}
+			return changes
+		}
+		for i := 0; i < a.NumField(); i++ {
+			f := a.Type().Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			// Properties held by Extra are marshaled along with the others.
+			p := path
+			if f.Name != "Extra" || f.Type != extraType {
+				p = join(path, fieldName(f))
+			}
+			changes = diffValue(changes, p, a.Field(i), b.Field(i))
+		}
+		return changes
+
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return append(changes, Change{path, value(a), value(b)})
+		}
+		for i := 0; i < a.Len(); i++ {
+			changes = diffValue(changes, fmt.Sprintf("%s[%d]", path, i), a.Index(i), b.Index(i))
+		}
+		return changes
+
+	case reflect.Map:
+		keys := make(map[string]reflect.Value)
+		for _, k := range append(a.MapKeys(), b.MapKeys()...) {
+			keys[fmt.Sprint(k.Interface())] = k
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			k := keys[name]
+			changes = diffValue(changes, join(path, name), a.MapIndex(k), b.MapIndex(k))
+		}
+		return changes
+	}
+
+	if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+		changes = append(changes, Change{path, value(a), value(b)})
+	}
+	return changes
+}
+
+// value returns the value held by v, dereferencing pointers to basic types so
+// that changes read well.
+func value(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		if v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct && v.Elem().Type() != timeType {
+			break
+		}
+		v = v.Elem()
+	}
+	return v.Interface()
+}
+
+// fieldName returns the JSON property name of f. Fields which are marshaled
+// separately, such as the options of a connection, are named after the Go
+// field in snake case.
+func fieldName(f reflect.StructField) string {
+	name, _ := jsonField(f)
+	if name != "" {
+		return name
+	}
+	var b strings.Builder
+	r := []rune(f.Name)
+	for i, c := range r {
+		if i > 0 && unicode.IsUpper(c) && (unicode.IsLower(r[i-1]) || i+1 < len(r) && unicode.IsLower(r[i+1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(c))
+	}
+	return b.String()
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
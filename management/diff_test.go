@@ -0,0 +1,82 @@
+package management
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestClone(t *testing.T) {
+
+	c := &Client{
+		Name:             auth0.String("app"),
+		Callbacks:        []interface{}{"https://a"},
+		JWTConfiguration: &ClientJWTConfiguration{Algorithm: auth0.String("RS256")},
+		ClientMetadata:   map[string]string{"key": "value"},
+		Extra:            map[string]interface{}{"future": map[string]interface{}{"enabled": true}},
+	}
+
+	clone := c.Clone()
+	if !c.Equal(clone) {
+		t.Fatalf("Expected the clone to be equal, got %v", c.Diff(clone))
+	}
+
+	*clone.Name = "renamed"
+	clone.Callbacks[0] = "https://b"
+	*clone.JWTConfiguration.Algorithm = "HS256"
+	clone.ClientMetadata["key"] = "other"
+	clone.Extra["future"].(map[string]interface{})["enabled"] = false
+
+	expect.Expect(t, c.GetName(), "app")
+	expect.Expect(t, c.Callbacks[0], "https://a")
+	expect.Expect(t, c.JWTConfiguration.GetAlgorithm(), "RS256")
+	expect.Expect(t, c.ClientMetadata["key"], "value")
+	expect.Expect(t, c.Extra["future"], map[string]interface{}{"enabled": true})
+
+	expect.Expect(t, (*Client)(nil).Clone(), (*Client)(nil))
+}
+
+func TestDiff(t *testing.T) {
+
+	created := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	a := &Connection{
+		Name:     auth0.String("db"),
+		Strategy: auth0.String("auth0"),
+		Options: &ConnectionOptions{
+			PasswordPolicy:     auth0.String("good"),
+			NonPersistentAttrs: &[]string{"email"},
+		},
+		EnabledClients: []interface{}{"1", "2"},
+		Metadata:       map[string]string{"a": "1"},
+		Extra:          map[string]interface{}{"future": 1},
+	}
+	b := &Connection{
+		Name:     auth0.String("db"),
+		Strategy: auth0.String("auth0"),
+		Options: &ConnectionOptions{
+			PasswordPolicy:     auth0.String("fair"),
+			NonPersistentAttrs: &[]string{"username"},
+		},
+		EnabledClients: []interface{}{"1"},
+		Metadata:       map[string]string{"b": "2"},
+		Extra:          map[string]interface{}{},
+	}
+
+	expect.Expect(t, a.Equal(b), false)
+	expect.Expect(t, a.Diff(b), []Change{
+		{"options.passwordPolicy", "good", "fair"},
+		{"options.non_persistent_attrs[0]", "email", "username"},
+		{"enabled_clients", []interface{}{"1", "2"}, []interface{}{"1"}},
+		{"metadata.a", "1", nil},
+		{"metadata.b", nil, "2"},
+		{"future", 1, nil},
+	})
+
+	expect.Expect(t, (&User{}).Diff(&User{Identities: []*UserIdentity{}}), []Change(nil))
+	expect.Expect(t, (&User{CreatedAt: &created}).Diff(&User{CreatedAt: auth0.Time(created.In(time.Local))}), []Change(nil))
+	expect.Expect(t, (&User{}).Diff(nil), []Change{{"", &User{}, nil}})
+	expect.Expect(t, (&Change{"name", "a", "b"}).String(), "name: a -> b")
+}
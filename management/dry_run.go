@@ -0,0 +1,132 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Plan records the requests modifying resources which a client configured
+// using WithDryRun did not send. It is safe for concurrent use, and its zero
+// value is ready to use.
+type Plan struct {
+	mu        sync.Mutex
+	mutations []PlannedMutation
+}
+
+// PlannedMutation is a request modifying resources recorded by a Plan.
+type PlannedMutation struct {
+	// The logical operation, made of the manager and method names, such as
+	// "Client.Update". Empty when the request was not sent by a manager, nor
+	// named using Operation.
+	Operation string
+
+	// The HTTP method and URI of the request.
+	Method string
+	URI    string
+
+	// The decoded JSON payload of the request, or nil if there is none. Other
+	// payloads, such as the users file of an import job, are summarized by
+	// their content type and size, as in "[multipart/form-data, 512 bytes]".
+	Payload interface{}
+}
+
+// Mutations returns the requests recorded so far, in the order they were
+// made.
+func (p *Plan) Mutations() []PlannedMutation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlannedMutation(nil), p.mutations...)
+}
+
+// String returns the recorded requests in a form suited for review, one per
+// paragraph, followed by their indented payload.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for i, mut := range p.Mutations() {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "%s %s\n", mut.Method, mut.URI)
+		if mut.Payload != nil {
+			payload, _ := json.MarshalIndent(mut.Payload, "", "  ")
+			fmt.Fprintf(&b, "%s\n", payload)
+		}
+	}
+	return b.String()
+}
+
+func (p *Plan) add(mut PlannedMutation) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.mutations = append(p.mutations, mut)
+}
+
+// WithDryRun configures the management client to record requests modifying
+// resources in the plan instead of sending them. Other requests are sent
+// normally.
+//
+// Recorded requests succeed as if the Auth0 Management API had returned
+// their JSON payload unchanged, an empty object for other payloads, or no
+// content when they have none. Values returned by the server, such as the
+// identifiers of created resources, are therefore not set.
+func WithDryRun(plan *Plan) ManagementOption {
+	return func(m *Management) {
+		m.plan = plan
+	}
+}
+
+// dryRun records the request in the plan and returns a plausible response.
+func (m *Management) dryRun(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mut := PlannedMutation{
+		Operation: operation(req),
+		Method:    req.Method,
+		URI:       req.URL.String(),
+	}
+	echo := body
+	if len(bytes.TrimSpace(body)) > 0 {
+		d := json.NewDecoder(bytes.NewReader(body))
+		d.UseNumber()
+		if err := d.Decode(&mut.Payload); err != nil {
+			// Payloads which are not JSON, such as the file of an import job,
+			// may hold sensitive data and are answered with an empty object.
+			contentType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+			mut.Payload = fmt.Sprintf("[%s, %d bytes]", contentType, len(body))
+			echo = []byte("{}")
+		}
+	}
+	m.plan.add(mut)
+
+	res := &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(echo)),
+		Request:    req,
+	}
+	if mut.Payload == nil {
+		res.Status = "204 No Content"
+		res.StatusCode = http.StatusNoContent
+		res.Header = http.Header{}
+	}
+	res.ContentLength = int64(len(echo))
+	return res, nil
+}
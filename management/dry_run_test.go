@@ -0,0 +1,102 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestDryRun(t *testing.T) {
+
+	var sent []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		w.Write([]byte(`{"client_id":"app","name":"app"}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	var plan Plan
+	m, err := New(s.URL, WithInsecure(), WithDryRun(&plan))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := m.Client.Read("app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, c.GetName(), "app")
+
+	u := &Client{Name: auth0.String("renamed")}
+	if err := m.Client.Update("app", u, Null("description")); err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, u.GetName(), "renamed")
+
+	if err := m.Client.Delete("app"); err != nil {
+		t.Fatal(err)
+	}
+
+	expect.Expect(t, sent, []string{"GET /api/v2/clients/app"})
+	expect.Expect(t, plan.Mutations(), []PlannedMutation{
+		{
+			Operation: "Client.Update",
+			Method:    http.MethodPatch,
+			URI:       s.URL + "/api/v2/clients/app",
+			Payload:   map[string]interface{}{"name": "renamed", "description": nil},
+		},
+		{
+			Operation: "Client.Delete",
+			Method:    http.MethodDelete,
+			URI:       s.URL + "/api/v2/clients/app",
+		},
+	})
+
+	b, _ := json.MarshalIndent(map[string]interface{}{"description": nil, "name": "renamed"}, "", "  ")
+	expect.Expect(t, plan.String(), "PATCH "+s.URL+"/api/v2/clients/app\n"+string(b)+"\n\nDELETE "+s.URL+"/api/v2/clients/app\n")
+
+	var imports Plan
+	m, err = New(s.URL, WithInsecure(), WithDryRun(&imports))
+	if err != nil {
+		t.Fatal(err)
+	}
+	j := &Job{
+		ConnectionID: auth0.String("con_123"),
+		Users:        []map[string]interface{}{{"email": "jane@example.com"}},
+	}
+	if err := m.Job.ImportUsers(j); err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, j.GetConnectionID(), "con_123")
+
+	mutations := imports.Mutations()
+	expect.Expect(t, len(mutations), 1)
+	expect.Expect(t, mutations[0].Operation, "Job.ImportUsers")
+	expect.Expect(t, mutations[0].URI, s.URL+"/api/v2/jobs/users-imports")
+	if p, _ := mutations[0].Payload.(string); !strings.HasPrefix(p, "[multipart/form-data, ") || strings.Contains(p, "jane@example.com") {
+		t.Errorf("Expected the payload to summarize the users file, got %v", mutations[0].Payload)
+	}
+	if strings.Contains(imports.String(), "jane@example.com") {
+		t.Error("Expected the plan not to hold the users file")
+	}
+
+	i := &UserImporter{Job: m.Job}
+	report, err := i.ImportFrom(context.Background(), &Job{ConnectionID: auth0.String("con_123")},
+		strings.NewReader(`{"email":"jane@example.com"}`+"\n"+`{"email":"john@example.com"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, report.Users, 2)
+	expect.Expect(t, len(report.Jobs), 1)
+	expect.Expect(t, len(report.Failures), 0)
+	expect.Expect(t, len(imports.Mutations()), 2)
+
+	expect.Expect(t, len(sent), 1)
+}
@@ -14,6 +14,9 @@ type Email struct {
 
 	Credentials *EmailCredentials      `json:"credentials,omitempty"`
 	Settings    map[string]interface{} `json:"settings,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type EmailCredentials struct {
@@ -37,6 +40,8 @@ type EmailCredentials struct {
 	SMTPPass *string `json:"smtp_pass,omitempty"`
 	// Domain
 	Domain *string `json:"domain,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type EmailManager struct {
@@ -74,7 +79,7 @@ func newEmailManager(m *Management) *EmailManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Emails/post_provider
 func (m *EmailManager) Create(e *Email, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("emails", "provider"), e, opts...)
+	return m.Request("POST", m.URI("emails", "provider"), e, withOperation("Email.Create", opts)...)
 }
 
 // Retrieve email provider details.
@@ -83,7 +88,7 @@ func (m *EmailManager) Create(e *Email, opts ...RequestOption) error {
 func (m *EmailManager) Read(opts ...RequestOption) (e *Email, err error) {
 	opts = append(opts,
 		WithFields("name", "enabled", "default_from_address", "credentials", "settings"))
-	err = m.Request("GET", m.URI("emails", "provider"), &e, opts...)
+	err = m.Request("GET", m.URI("emails", "provider"), &e, withOperation("Email.Read", opts)...)
 	return
 }
 
@@ -91,12 +96,12 @@ func (m *EmailManager) Read(opts ...RequestOption) (e *Email, err error) {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Emails/patch_provider
 func (m *EmailManager) Update(e *Email, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("emails", "provider"), e, opts...)
+	return m.Request("PATCH", m.URI("emails", "provider"), e, withOperation("Email.Update", opts)...)
 }
 
 // Delete the email provider.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Emails/delete_provider
 func (m *EmailManager) Delete(opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("emails", "provider"), nil, opts...)
+	return m.Request("DELETE", m.URI("emails", "provider"), nil, withOperation("Email.Delete", opts)...)
 }
@@ -28,6 +28,9 @@ type EmailTemplate struct {
 
 	// Whether or not the template is enabled.
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type EmailTemplateManager struct {
@@ -42,7 +45,7 @@ func newEmailTemplateManager(m *Management) *EmailTemplateManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Email_Templates/post_email_templates
 func (m *EmailTemplateManager) Create(e *EmailTemplate, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("email-templates"), e, opts...)
+	return m.Request("POST", m.URI("email-templates"), e, withOperation("EmailTemplate.Create", opts)...)
 }
 
 // Retrieve an email template by pre-defined name.
@@ -56,7 +59,7 @@ func (m *EmailTemplateManager) Create(e *EmailTemplate, opts ...RequestOption) e
 //
 // See: https://auth0.com/docs/api/management/v2#!/Email_Templates/get_email_templates_by_templateName
 func (m *EmailTemplateManager) Read(template string, opts ...RequestOption) (e *EmailTemplate, err error) {
-	err = m.Request("GET", m.URI("email-templates", template), &e, opts...)
+	err = m.Request("GET", m.URI("email-templates", template), &e, withOperation("EmailTemplate.Read", opts)...)
 	return
 }
 
@@ -64,12 +67,12 @@ func (m *EmailTemplateManager) Read(template string, opts ...RequestOption) (e *
 //
 // See: https://auth0.com/docs/api/management/v2#!/Email_Templates/patch_email_templates_by_templateName
 func (m *EmailTemplateManager) Update(template string, e *EmailTemplate, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("email-templates", template), e, opts...)
+	return m.Request("PATCH", m.URI("email-templates", template), e, withOperation("EmailTemplate.Update", opts)...)
 }
 
 // Replace an email template.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Email_Templates/put_email_templates_by_templateName
 func (m *EmailTemplateManager) Replace(template string, e *EmailTemplate, opts ...RequestOption) (err error) {
-	return m.Request("PUT", m.URI("email-templates", template), e, opts...)
+	return m.Request("PUT", m.URI("email-templates", template), e, withOperation("EmailTemplate.Replace", opts)...)
 }
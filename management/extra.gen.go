@@ -0,0 +1,1454 @@
+// Code generated by gen-extra; DO NOT EDIT.
+
+package management
+
+import "encoding/json"
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (a *Action) MarshalJSON() ([]byte, error) {
+	type action Action
+	data, err := json.Marshal((*action)(a))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, a.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (a *Action) UnmarshalJSON(data []byte) error {
+	type action Action
+	err := json.Unmarshal(data, (*action)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra, err = unmarshalExtra(data, (*action)(a))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (a *ActionBinding) MarshalJSON() ([]byte, error) {
+	type actionBinding ActionBinding
+	data, err := json.Marshal((*actionBinding)(a))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, a.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (a *ActionBinding) UnmarshalJSON(data []byte) error {
+	type actionBinding ActionBinding
+	err := json.Unmarshal(data, (*actionBinding)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra, err = unmarshalExtra(data, (*actionBinding)(a))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (a *ActionBindingReference) MarshalJSON() ([]byte, error) {
+	type actionBindingReference ActionBindingReference
+	data, err := json.Marshal((*actionBindingReference)(a))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, a.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (a *ActionBindingReference) UnmarshalJSON(data []byte) error {
+	type actionBindingReference ActionBindingReference
+	err := json.Unmarshal(data, (*actionBindingReference)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra, err = unmarshalExtra(data, (*actionBindingReference)(a))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (a *ActionDependency) MarshalJSON() ([]byte, error) {
+	type actionDependency ActionDependency
+	data, err := json.Marshal((*actionDependency)(a))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, a.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (a *ActionDependency) UnmarshalJSON(data []byte) error {
+	type actionDependency ActionDependency
+	err := json.Unmarshal(data, (*actionDependency)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra, err = unmarshalExtra(data, (*actionDependency)(a))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (a *ActionSecret) MarshalJSON() ([]byte, error) {
+	type actionSecret ActionSecret
+	data, err := json.Marshal((*actionSecret)(a))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, a.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (a *ActionSecret) UnmarshalJSON(data []byte) error {
+	type actionSecret ActionSecret
+	err := json.Unmarshal(data, (*actionSecret)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra, err = unmarshalExtra(data, (*actionSecret)(a))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (a *ActionTrigger) MarshalJSON() ([]byte, error) {
+	type actionTrigger ActionTrigger
+	data, err := json.Marshal((*actionTrigger)(a))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, a.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (a *ActionTrigger) UnmarshalJSON(data []byte) error {
+	type actionTrigger ActionTrigger
+	err := json.Unmarshal(data, (*actionTrigger)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra, err = unmarshalExtra(data, (*actionTrigger)(a))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (b *Branding) MarshalJSON() ([]byte, error) {
+	type branding Branding
+	data, err := json.Marshal((*branding)(b))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, b.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (b *Branding) UnmarshalJSON(data []byte) error {
+	type branding Branding
+	err := json.Unmarshal(data, (*branding)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra, err = unmarshalExtra(data, (*branding)(b))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (b *BrandingFont) MarshalJSON() ([]byte, error) {
+	type brandingFont BrandingFont
+	data, err := json.Marshal((*brandingFont)(b))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, b.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (b *BrandingFont) UnmarshalJSON(data []byte) error {
+	type brandingFont BrandingFont
+	err := json.Unmarshal(data, (*brandingFont)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra, err = unmarshalExtra(data, (*brandingFont)(b))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (b *BrandingPageBackgroundGradient) MarshalJSON() ([]byte, error) {
+	type brandingPageBackgroundGradient BrandingPageBackgroundGradient
+	data, err := json.Marshal((*brandingPageBackgroundGradient)(b))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, b.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (b *BrandingPageBackgroundGradient) UnmarshalJSON(data []byte) error {
+	type brandingPageBackgroundGradient BrandingPageBackgroundGradient
+	err := json.Unmarshal(data, (*brandingPageBackgroundGradient)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra, err = unmarshalExtra(data, (*brandingPageBackgroundGradient)(b))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (b *BrandingUniversalLogin) MarshalJSON() ([]byte, error) {
+	type brandingUniversalLogin BrandingUniversalLogin
+	data, err := json.Marshal((*brandingUniversalLogin)(b))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, b.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (b *BrandingUniversalLogin) UnmarshalJSON(data []byte) error {
+	type brandingUniversalLogin BrandingUniversalLogin
+	err := json.Unmarshal(data, (*brandingUniversalLogin)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra, err = unmarshalExtra(data, (*brandingUniversalLogin)(b))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *Client) MarshalJSON() ([]byte, error) {
+	type client Client
+	data, err := json.Marshal((*client)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *Client) UnmarshalJSON(data []byte) error {
+	type client Client
+	err := json.Unmarshal(data, (*client)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*client)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ClientGrant) MarshalJSON() ([]byte, error) {
+	type clientGrant ClientGrant
+	data, err := json.Marshal((*clientGrant)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ClientGrant) UnmarshalJSON(data []byte) error {
+	type clientGrant ClientGrant
+	err := json.Unmarshal(data, (*clientGrant)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*clientGrant)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ClientNativeSocialLogin) MarshalJSON() ([]byte, error) {
+	type clientNativeSocialLogin ClientNativeSocialLogin
+	data, err := json.Marshal((*clientNativeSocialLogin)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ClientNativeSocialLogin) UnmarshalJSON(data []byte) error {
+	type clientNativeSocialLogin ClientNativeSocialLogin
+	err := json.Unmarshal(data, (*clientNativeSocialLogin)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*clientNativeSocialLogin)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ClientRefreshToken) MarshalJSON() ([]byte, error) {
+	type clientRefreshToken ClientRefreshToken
+	data, err := json.Marshal((*clientRefreshToken)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ClientRefreshToken) UnmarshalJSON(data []byte) error {
+	type clientRefreshToken ClientRefreshToken
+	err := json.Unmarshal(data, (*clientRefreshToken)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*clientRefreshToken)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionGatewayAuthentication) MarshalJSON() ([]byte, error) {
+	type connectionGatewayAuthentication ConnectionGatewayAuthentication
+	data, err := json.Marshal((*connectionGatewayAuthentication)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionGatewayAuthentication) UnmarshalJSON(data []byte) error {
+	type connectionGatewayAuthentication ConnectionGatewayAuthentication
+	err := json.Unmarshal(data, (*connectionGatewayAuthentication)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionGatewayAuthentication)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptions) MarshalJSON() ([]byte, error) {
+	type connectionOptions ConnectionOptions
+	data, err := json.Marshal((*connectionOptions)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptions) UnmarshalJSON(data []byte) error {
+	type connectionOptions ConnectionOptions
+	err := json.Unmarshal(data, (*connectionOptions)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptions)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsAD) MarshalJSON() ([]byte, error) {
+	type connectionOptionsAD ConnectionOptionsAD
+	data, err := json.Marshal((*connectionOptionsAD)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsAD) UnmarshalJSON(data []byte) error {
+	type connectionOptionsAD ConnectionOptionsAD
+	err := json.Unmarshal(data, (*connectionOptionsAD)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsAD)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsADFS) MarshalJSON() ([]byte, error) {
+	type connectionOptionsADFS ConnectionOptionsADFS
+	data, err := json.Marshal((*connectionOptionsADFS)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsADFS) UnmarshalJSON(data []byte) error {
+	type connectionOptionsADFS ConnectionOptionsADFS
+	err := json.Unmarshal(data, (*connectionOptionsADFS)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsADFS)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsApple) MarshalJSON() ([]byte, error) {
+	type connectionOptionsApple ConnectionOptionsApple
+	data, err := json.Marshal((*connectionOptionsApple)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsApple) UnmarshalJSON(data []byte) error {
+	type connectionOptionsApple ConnectionOptionsApple
+	err := json.Unmarshal(data, (*connectionOptionsApple)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsApple)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsAzureAD) MarshalJSON() ([]byte, error) {
+	type connectionOptionsAzureAD ConnectionOptionsAzureAD
+	data, err := json.Marshal((*connectionOptionsAzureAD)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsAzureAD) UnmarshalJSON(data []byte) error {
+	type connectionOptionsAzureAD ConnectionOptionsAzureAD
+	err := json.Unmarshal(data, (*connectionOptionsAzureAD)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsAzureAD)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsEmail) MarshalJSON() ([]byte, error) {
+	type connectionOptionsEmail ConnectionOptionsEmail
+	data, err := json.Marshal((*connectionOptionsEmail)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsEmail) UnmarshalJSON(data []byte) error {
+	type connectionOptionsEmail ConnectionOptionsEmail
+	err := json.Unmarshal(data, (*connectionOptionsEmail)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsEmail)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsEmailSettings) MarshalJSON() ([]byte, error) {
+	type connectionOptionsEmailSettings ConnectionOptionsEmailSettings
+	data, err := json.Marshal((*connectionOptionsEmailSettings)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsEmailSettings) UnmarshalJSON(data []byte) error {
+	type connectionOptionsEmailSettings ConnectionOptionsEmailSettings
+	err := json.Unmarshal(data, (*connectionOptionsEmailSettings)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsEmailSettings)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsFacebook) MarshalJSON() ([]byte, error) {
+	type connectionOptionsFacebook ConnectionOptionsFacebook
+	data, err := json.Marshal((*connectionOptionsFacebook)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsFacebook) UnmarshalJSON(data []byte) error {
+	type connectionOptionsFacebook ConnectionOptionsFacebook
+	err := json.Unmarshal(data, (*connectionOptionsFacebook)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsFacebook)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsGitHub) MarshalJSON() ([]byte, error) {
+	type connectionOptionsGitHub ConnectionOptionsGitHub
+	data, err := json.Marshal((*connectionOptionsGitHub)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsGitHub) UnmarshalJSON(data []byte) error {
+	type connectionOptionsGitHub ConnectionOptionsGitHub
+	err := json.Unmarshal(data, (*connectionOptionsGitHub)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsGitHub)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsGoogleApps) MarshalJSON() ([]byte, error) {
+	type connectionOptionsGoogleApps ConnectionOptionsGoogleApps
+	data, err := json.Marshal((*connectionOptionsGoogleApps)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsGoogleApps) UnmarshalJSON(data []byte) error {
+	type connectionOptionsGoogleApps ConnectionOptionsGoogleApps
+	err := json.Unmarshal(data, (*connectionOptionsGoogleApps)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsGoogleApps)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsGoogleOAuth2) MarshalJSON() ([]byte, error) {
+	type connectionOptionsGoogleOAuth2 ConnectionOptionsGoogleOAuth2
+	data, err := json.Marshal((*connectionOptionsGoogleOAuth2)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsGoogleOAuth2) UnmarshalJSON(data []byte) error {
+	type connectionOptionsGoogleOAuth2 ConnectionOptionsGoogleOAuth2
+	err := json.Unmarshal(data, (*connectionOptionsGoogleOAuth2)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsGoogleOAuth2)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsLinkedin) MarshalJSON() ([]byte, error) {
+	type connectionOptionsLinkedin ConnectionOptionsLinkedin
+	data, err := json.Marshal((*connectionOptionsLinkedin)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsLinkedin) UnmarshalJSON(data []byte) error {
+	type connectionOptionsLinkedin ConnectionOptionsLinkedin
+	err := json.Unmarshal(data, (*connectionOptionsLinkedin)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsLinkedin)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsOAuth2) MarshalJSON() ([]byte, error) {
+	type connectionOptionsOAuth2 ConnectionOptionsOAuth2
+	data, err := json.Marshal((*connectionOptionsOAuth2)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsOAuth2) UnmarshalJSON(data []byte) error {
+	type connectionOptionsOAuth2 ConnectionOptionsOAuth2
+	err := json.Unmarshal(data, (*connectionOptionsOAuth2)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsOAuth2)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsOIDC) MarshalJSON() ([]byte, error) {
+	type connectionOptionsOIDC ConnectionOptionsOIDC
+	data, err := json.Marshal((*connectionOptionsOIDC)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsOIDC) UnmarshalJSON(data []byte) error {
+	type connectionOptionsOIDC ConnectionOptionsOIDC
+	err := json.Unmarshal(data, (*connectionOptionsOIDC)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsOIDC)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsOTP) MarshalJSON() ([]byte, error) {
+	type connectionOptionsOTP ConnectionOptionsOTP
+	data, err := json.Marshal((*connectionOptionsOTP)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsOTP) UnmarshalJSON(data []byte) error {
+	type connectionOptionsOTP ConnectionOptionsOTP
+	err := json.Unmarshal(data, (*connectionOptionsOTP)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsOTP)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsSAML) MarshalJSON() ([]byte, error) {
+	type connectionOptionsSAML ConnectionOptionsSAML
+	data, err := json.Marshal((*connectionOptionsSAML)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsSAML) UnmarshalJSON(data []byte) error {
+	type connectionOptionsSAML ConnectionOptionsSAML
+	err := json.Unmarshal(data, (*connectionOptionsSAML)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsSAML)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsSAMLIdpInitiated) MarshalJSON() ([]byte, error) {
+	type connectionOptionsSAMLIdpInitiated ConnectionOptionsSAMLIdpInitiated
+	data, err := json.Marshal((*connectionOptionsSAMLIdpInitiated)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsSAMLIdpInitiated) UnmarshalJSON(data []byte) error {
+	type connectionOptionsSAMLIdpInitiated ConnectionOptionsSAMLIdpInitiated
+	err := json.Unmarshal(data, (*connectionOptionsSAMLIdpInitiated)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsSAMLIdpInitiated)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsSAMLSigningKey) MarshalJSON() ([]byte, error) {
+	type connectionOptionsSAMLSigningKey ConnectionOptionsSAMLSigningKey
+	data, err := json.Marshal((*connectionOptionsSAMLSigningKey)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsSAMLSigningKey) UnmarshalJSON(data []byte) error {
+	type connectionOptionsSAMLSigningKey ConnectionOptionsSAMLSigningKey
+	err := json.Unmarshal(data, (*connectionOptionsSAMLSigningKey)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsSAMLSigningKey)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsSMS) MarshalJSON() ([]byte, error) {
+	type connectionOptionsSMS ConnectionOptionsSMS
+	data, err := json.Marshal((*connectionOptionsSMS)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsSMS) UnmarshalJSON(data []byte) error {
+	type connectionOptionsSMS ConnectionOptionsSMS
+	err := json.Unmarshal(data, (*connectionOptionsSMS)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsSMS)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsSalesforce) MarshalJSON() ([]byte, error) {
+	type connectionOptionsSalesforce ConnectionOptionsSalesforce
+	data, err := json.Marshal((*connectionOptionsSalesforce)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsSalesforce) UnmarshalJSON(data []byte) error {
+	type connectionOptionsSalesforce ConnectionOptionsSalesforce
+	err := json.Unmarshal(data, (*connectionOptionsSalesforce)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsSalesforce)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *ConnectionOptionsWindowsLive) MarshalJSON() ([]byte, error) {
+	type connectionOptionsWindowsLive ConnectionOptionsWindowsLive
+	data, err := json.Marshal((*connectionOptionsWindowsLive)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *ConnectionOptionsWindowsLive) UnmarshalJSON(data []byte) error {
+	type connectionOptionsWindowsLive ConnectionOptionsWindowsLive
+	err := json.Unmarshal(data, (*connectionOptionsWindowsLive)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*connectionOptionsWindowsLive)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (c *CustomDomain) MarshalJSON() ([]byte, error) {
+	type customDomain CustomDomain
+	data, err := json.Marshal((*customDomain)(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, c.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (c *CustomDomain) UnmarshalJSON(data []byte) error {
+	type customDomain CustomDomain
+	err := json.Unmarshal(data, (*customDomain)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra, err = unmarshalExtra(data, (*customDomain)(c))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (e *Email) MarshalJSON() ([]byte, error) {
+	type email Email
+	data, err := json.Marshal((*email)(e))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, e.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (e *Email) UnmarshalJSON(data []byte) error {
+	type email Email
+	err := json.Unmarshal(data, (*email)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra, err = unmarshalExtra(data, (*email)(e))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (e *EmailCredentials) MarshalJSON() ([]byte, error) {
+	type emailCredentials EmailCredentials
+	data, err := json.Marshal((*emailCredentials)(e))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, e.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (e *EmailCredentials) UnmarshalJSON(data []byte) error {
+	type emailCredentials EmailCredentials
+	err := json.Unmarshal(data, (*emailCredentials)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra, err = unmarshalExtra(data, (*emailCredentials)(e))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (e *EmailTemplate) MarshalJSON() ([]byte, error) {
+	type emailTemplate EmailTemplate
+	data, err := json.Marshal((*emailTemplate)(e))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, e.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (e *EmailTemplate) UnmarshalJSON(data []byte) error {
+	type emailTemplate EmailTemplate
+	err := json.Unmarshal(data, (*emailTemplate)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra, err = unmarshalExtra(data, (*emailTemplate)(e))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (h *Hook) MarshalJSON() ([]byte, error) {
+	type hook Hook
+	data, err := json.Marshal((*hook)(h))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, h.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (h *Hook) UnmarshalJSON(data []byte) error {
+	type hook Hook
+	err := json.Unmarshal(data, (*hook)(h))
+	if err != nil {
+		return err
+	}
+	h.Extra, err = unmarshalExtra(data, (*hook)(h))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (l *LogStreamSinkAmazonEventBridge) MarshalJSON() ([]byte, error) {
+	type logStreamSinkAmazonEventBridge LogStreamSinkAmazonEventBridge
+	data, err := json.Marshal((*logStreamSinkAmazonEventBridge)(l))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, l.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (l *LogStreamSinkAmazonEventBridge) UnmarshalJSON(data []byte) error {
+	type logStreamSinkAmazonEventBridge LogStreamSinkAmazonEventBridge
+	err := json.Unmarshal(data, (*logStreamSinkAmazonEventBridge)(l))
+	if err != nil {
+		return err
+	}
+	l.Extra, err = unmarshalExtra(data, (*logStreamSinkAmazonEventBridge)(l))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (l *LogStreamSinkAzureEventGrid) MarshalJSON() ([]byte, error) {
+	type logStreamSinkAzureEventGrid LogStreamSinkAzureEventGrid
+	data, err := json.Marshal((*logStreamSinkAzureEventGrid)(l))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, l.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (l *LogStreamSinkAzureEventGrid) UnmarshalJSON(data []byte) error {
+	type logStreamSinkAzureEventGrid LogStreamSinkAzureEventGrid
+	err := json.Unmarshal(data, (*logStreamSinkAzureEventGrid)(l))
+	if err != nil {
+		return err
+	}
+	l.Extra, err = unmarshalExtra(data, (*logStreamSinkAzureEventGrid)(l))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (l *LogStreamSinkDatadog) MarshalJSON() ([]byte, error) {
+	type logStreamSinkDatadog LogStreamSinkDatadog
+	data, err := json.Marshal((*logStreamSinkDatadog)(l))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, l.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (l *LogStreamSinkDatadog) UnmarshalJSON(data []byte) error {
+	type logStreamSinkDatadog LogStreamSinkDatadog
+	err := json.Unmarshal(data, (*logStreamSinkDatadog)(l))
+	if err != nil {
+		return err
+	}
+	l.Extra, err = unmarshalExtra(data, (*logStreamSinkDatadog)(l))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (l *LogStreamSinkHTTP) MarshalJSON() ([]byte, error) {
+	type logStreamSinkHTTP LogStreamSinkHTTP
+	data, err := json.Marshal((*logStreamSinkHTTP)(l))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, l.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (l *LogStreamSinkHTTP) UnmarshalJSON(data []byte) error {
+	type logStreamSinkHTTP LogStreamSinkHTTP
+	err := json.Unmarshal(data, (*logStreamSinkHTTP)(l))
+	if err != nil {
+		return err
+	}
+	l.Extra, err = unmarshalExtra(data, (*logStreamSinkHTTP)(l))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (l *LogStreamSinkSplunk) MarshalJSON() ([]byte, error) {
+	type logStreamSinkSplunk LogStreamSinkSplunk
+	data, err := json.Marshal((*logStreamSinkSplunk)(l))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, l.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (l *LogStreamSinkSplunk) UnmarshalJSON(data []byte) error {
+	type logStreamSinkSplunk LogStreamSinkSplunk
+	err := json.Unmarshal(data, (*logStreamSinkSplunk)(l))
+	if err != nil {
+		return err
+	}
+	l.Extra, err = unmarshalExtra(data, (*logStreamSinkSplunk)(l))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (l *LogStreamSinkSumo) MarshalJSON() ([]byte, error) {
+	type logStreamSinkSumo LogStreamSinkSumo
+	data, err := json.Marshal((*logStreamSinkSumo)(l))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, l.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (l *LogStreamSinkSumo) UnmarshalJSON(data []byte) error {
+	type logStreamSinkSumo LogStreamSinkSumo
+	err := json.Unmarshal(data, (*logStreamSinkSumo)(l))
+	if err != nil {
+		return err
+	}
+	l.Extra, err = unmarshalExtra(data, (*logStreamSinkSumo)(l))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (m *MultiFactorProviderAmazonSNS) MarshalJSON() ([]byte, error) {
+	type multiFactorProviderAmazonSNS MultiFactorProviderAmazonSNS
+	data, err := json.Marshal((*multiFactorProviderAmazonSNS)(m))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, m.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (m *MultiFactorProviderAmazonSNS) UnmarshalJSON(data []byte) error {
+	type multiFactorProviderAmazonSNS MultiFactorProviderAmazonSNS
+	err := json.Unmarshal(data, (*multiFactorProviderAmazonSNS)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra, err = unmarshalExtra(data, (*multiFactorProviderAmazonSNS)(m))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (m *MultiFactorProviderTwilio) MarshalJSON() ([]byte, error) {
+	type multiFactorProviderTwilio MultiFactorProviderTwilio
+	data, err := json.Marshal((*multiFactorProviderTwilio)(m))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, m.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (m *MultiFactorProviderTwilio) UnmarshalJSON(data []byte) error {
+	type multiFactorProviderTwilio MultiFactorProviderTwilio
+	err := json.Unmarshal(data, (*multiFactorProviderTwilio)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra, err = unmarshalExtra(data, (*multiFactorProviderTwilio)(m))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (m *MultiFactorSMSTemplate) MarshalJSON() ([]byte, error) {
+	type multiFactorSMSTemplate MultiFactorSMSTemplate
+	data, err := json.Marshal((*multiFactorSMSTemplate)(m))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, m.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (m *MultiFactorSMSTemplate) UnmarshalJSON(data []byte) error {
+	type multiFactorSMSTemplate MultiFactorSMSTemplate
+	err := json.Unmarshal(data, (*multiFactorSMSTemplate)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra, err = unmarshalExtra(data, (*multiFactorSMSTemplate)(m))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (o *Organization) MarshalJSON() ([]byte, error) {
+	type organization Organization
+	data, err := json.Marshal((*organization)(o))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, o.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (o *Organization) UnmarshalJSON(data []byte) error {
+	type organization Organization
+	err := json.Unmarshal(data, (*organization)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra, err = unmarshalExtra(data, (*organization)(o))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (o *OrganizationBranding) MarshalJSON() ([]byte, error) {
+	type organizationBranding OrganizationBranding
+	data, err := json.Marshal((*organizationBranding)(o))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, o.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (o *OrganizationBranding) UnmarshalJSON(data []byte) error {
+	type organizationBranding OrganizationBranding
+	err := json.Unmarshal(data, (*organizationBranding)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra, err = unmarshalExtra(data, (*organizationBranding)(o))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (p *Prompt) MarshalJSON() ([]byte, error) {
+	type prompt Prompt
+	data, err := json.Marshal((*prompt)(p))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, p.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (p *Prompt) UnmarshalJSON(data []byte) error {
+	type prompt Prompt
+	err := json.Unmarshal(data, (*prompt)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra, err = unmarshalExtra(data, (*prompt)(p))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (r *ResourceServer) MarshalJSON() ([]byte, error) {
+	type resourceServer ResourceServer
+	data, err := json.Marshal((*resourceServer)(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, r.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (r *ResourceServer) UnmarshalJSON(data []byte) error {
+	type resourceServer ResourceServer
+	err := json.Unmarshal(data, (*resourceServer)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra, err = unmarshalExtra(data, (*resourceServer)(r))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (r *ResourceServerScope) MarshalJSON() ([]byte, error) {
+	type resourceServerScope ResourceServerScope
+	data, err := json.Marshal((*resourceServerScope)(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, r.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (r *ResourceServerScope) UnmarshalJSON(data []byte) error {
+	type resourceServerScope ResourceServerScope
+	err := json.Unmarshal(data, (*resourceServerScope)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra, err = unmarshalExtra(data, (*resourceServerScope)(r))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (r *Role) MarshalJSON() ([]byte, error) {
+	type role Role
+	data, err := json.Marshal((*role)(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, r.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (r *Role) UnmarshalJSON(data []byte) error {
+	type role Role
+	err := json.Unmarshal(data, (*role)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra, err = unmarshalExtra(data, (*role)(r))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (r *Rule) MarshalJSON() ([]byte, error) {
+	type rule Rule
+	data, err := json.Marshal((*rule)(r))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, r.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (r *Rule) UnmarshalJSON(data []byte) error {
+	type rule Rule
+	err := json.Unmarshal(data, (*rule)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra, err = unmarshalExtra(data, (*rule)(r))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (t *TenantChangePassword) MarshalJSON() ([]byte, error) {
+	type tenantChangePassword TenantChangePassword
+	data, err := json.Marshal((*tenantChangePassword)(t))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, t.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (t *TenantChangePassword) UnmarshalJSON(data []byte) error {
+	type tenantChangePassword TenantChangePassword
+	err := json.Unmarshal(data, (*tenantChangePassword)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra, err = unmarshalExtra(data, (*tenantChangePassword)(t))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (t *TenantDeviceFlow) MarshalJSON() ([]byte, error) {
+	type tenantDeviceFlow TenantDeviceFlow
+	data, err := json.Marshal((*tenantDeviceFlow)(t))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, t.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (t *TenantDeviceFlow) UnmarshalJSON(data []byte) error {
+	type tenantDeviceFlow TenantDeviceFlow
+	err := json.Unmarshal(data, (*tenantDeviceFlow)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra, err = unmarshalExtra(data, (*tenantDeviceFlow)(t))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (t *TenantErrorPage) MarshalJSON() ([]byte, error) {
+	type tenantErrorPage TenantErrorPage
+	data, err := json.Marshal((*tenantErrorPage)(t))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, t.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (t *TenantErrorPage) UnmarshalJSON(data []byte) error {
+	type tenantErrorPage TenantErrorPage
+	err := json.Unmarshal(data, (*tenantErrorPage)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra, err = unmarshalExtra(data, (*tenantErrorPage)(t))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (t *TenantFlags) MarshalJSON() ([]byte, error) {
+	type tenantFlags TenantFlags
+	data, err := json.Marshal((*tenantFlags)(t))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, t.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (t *TenantFlags) UnmarshalJSON(data []byte) error {
+	type tenantFlags TenantFlags
+	err := json.Unmarshal(data, (*tenantFlags)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra, err = unmarshalExtra(data, (*tenantFlags)(t))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (t *TenantGuardianMFAPage) MarshalJSON() ([]byte, error) {
+	type tenantGuardianMFAPage TenantGuardianMFAPage
+	data, err := json.Marshal((*tenantGuardianMFAPage)(t))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, t.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (t *TenantGuardianMFAPage) UnmarshalJSON(data []byte) error {
+	type tenantGuardianMFAPage TenantGuardianMFAPage
+	err := json.Unmarshal(data, (*tenantGuardianMFAPage)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra, err = unmarshalExtra(data, (*tenantGuardianMFAPage)(t))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func (t *TenantUniversalLogin) MarshalJSON() ([]byte, error) {
+	type tenantUniversalLogin TenantUniversalLogin
+	data, err := json.Marshal((*tenantUniversalLogin)(t))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, t.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func (t *TenantUniversalLogin) UnmarshalJSON(data []byte) error {
+	type tenantUniversalLogin TenantUniversalLogin
+	err := json.Unmarshal(data, (*tenantUniversalLogin)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra, err = unmarshalExtra(data, (*tenantUniversalLogin)(t))
+	return err
+}
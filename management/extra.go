@@ -0,0 +1,133 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// knownFields caches the JSON property names known to struct types.
+var knownFields sync.Map // map[reflect.Type]map[string]bool
+
+// unmarshalExtra returns the properties of the JSON object b which are not
+// known to v, a pointer to the struct b was decoded into. Properties are
+// matched case insensitively, as encoding/json does.
+//
+// It returns nil if all properties are known, or if b is not an object.
+func unmarshalExtra(b []byte, v interface{}) (map[string]interface{}, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		if _, ok := err.(*json.UnmarshalTypeError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	known := fieldsOf(reflect.TypeOf(v))
+
+	var extra map[string]interface{}
+	for k, r := range raw {
+		if known[strings.ToLower(k)] {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(r, &value); err != nil {
+			return nil, err
+		}
+		if extra == nil {
+			extra = make(map[string]interface{})
+		}
+		extra[k] = value
+	}
+	return extra, nil
+}
+
+// marshalExtra adds the extra properties to the JSON object b. Properties
+// already present in b take precedence over extra ones.
+func marshalExtra(b []byte, extra map[string]interface{}) ([]byte, error) {
+	if len(extra) == 0 {
+		return b, nil
+	}
+
+	var present map[string]json.RawMessage
+	if err := json.Unmarshal(b, &present); err != nil || present == nil {
+		return b, err
+	}
+
+	keys := make([]string, 0, len(extra))
+	for k := range extra {
+		if _, ok := present[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return b, nil
+	}
+	sort.Strings(keys)
+
+	// Extra properties are appended to the object, without decoding it, so
+	// that the order of the known properties is kept.
+	b = bytes.TrimSpace(b)
+	var buf bytes.Buffer
+	buf.Write(b[:len(b)-1])
+	for i, k := range keys {
+		if i > 0 || len(present) > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(extra[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// fieldsOf returns the lower cased JSON property names of the fields of t,
+// including those of embedded structs.
+func fieldsOf(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if fields, ok := knownFields.Load(t); ok {
+		return fields.(map[string]bool)
+	}
+
+	fields := make(map[string]bool)
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+			if f.Anonymous && name == "" {
+				for k := range fieldsOf(f.Type) {
+					fields[k] = true
+				}
+				continue
+			}
+			if f.PkgPath != "" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			fields[strings.ToLower(name)] = true
+		}
+	}
+
+	knownFields.Store(t, fields)
+	return fields
+}
@@ -0,0 +1,89 @@
+package management
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestExtra(t *testing.T) {
+
+	t.Run("Client", func(t *testing.T) {
+		var c Client
+		err := json.Unmarshal([]byte(`{"name":"app","future_setting":{"enabled":true},"jwt_configuration":{"alg":"RS256","future_alg_setting":1}}`), &c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, c.GetName(), "app")
+		expect.Expect(t, c.Extra["future_setting"], map[string]interface{}{"enabled": true})
+		expect.Expect(t, c.JWTConfiguration.Extra["future_alg_setting"], float64(1))
+
+		c.Name = auth0.String("renamed")
+		b, err := json.Marshal(&c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, string(b), `{"name":"renamed","jwt_configuration":{"alg":"RS256","future_alg_setting":1},"future_setting":{"enabled":true}}`)
+	})
+
+	t.Run("Connection", func(t *testing.T) {
+		var c Connection
+		err := json.Unmarshal([]byte(`{"name":"db","strategy":"auth0","options":{"brute_force_protection":true,"future_option":"value"},"future_setting":2}`), &c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		o, ok := c.Options.(*ConnectionOptions)
+		if !ok {
+			t.Fatalf("unexpected options type %T", c.Options)
+		}
+		expect.Expect(t, o.Extra, map[string]interface{}{"future_option": "value"})
+		expect.Expect(t, c.Extra, map[string]interface{}{"future_setting": float64(2)})
+
+		b, err := json.Marshal(&c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, string(b), `{"name":"db","strategy":"auth0","options":{"brute_force_protection":true,"future_option":"value"},"future_setting":2}`)
+	})
+
+	t.Run("Tenant", func(t *testing.T) {
+		var tn Tenant
+		err := json.Unmarshal([]byte(`{"friendly_name":"Acme","flags":{"enable_apis_section":true,"future_flag":true},"session_lifetime_in_minutes":30}`), &tn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, tn.Extra, map[string]interface{}(nil))
+		expect.Expect(t, tn.GetSessionLifetime(), 0.5)
+		expect.Expect(t, tn.Flags.Extra, map[string]interface{}{"future_flag": true})
+
+		b, err := json.Marshal(&tn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, string(b), `{"flags":{"enable_apis_section":true,"future_flag":true},"friendly_name":"Acme","session_lifetime_in_minutes":30}`)
+	})
+
+	t.Run("KnownFieldsFirst", func(t *testing.T) {
+		r := &Role{
+			Name:  auth0.String("admin"),
+			Extra: map[string]interface{}{"name": "other", "future_setting": nil},
+		}
+		b, err := json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, string(b), `{"name":"admin","future_setting":null}`)
+	})
+
+	t.Run("CaseInsensitive", func(t *testing.T) {
+		var r Role
+		err := json.Unmarshal([]byte(`{"Name":"admin"}`), &r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, r.GetName(), "admin")
+		expect.Expect(t, r.Extra, map[string]interface{}(nil))
+	})
+}
@@ -0,0 +1,179 @@
+//go:build ignore
+// +build ignore
+
+// Generates context-first variants of the manager methods accepting request
+// options, such as UserManager.ReadContext for UserManager.Read.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	output = "context.gen.go"
+	suffix = ".gen.go"
+)
+
+var verbose = flag.Bool("v", false, "Print verbose log messages")
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+type method struct {
+	Receiver string
+	Name     string
+	Params   string
+	Args     string
+	Results  string
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", filter, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for pkgName, pkg := range pkgs {
+		var methods []method
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			methods = append(methods, process(fset, f)...)
+		}
+		sort.Slice(methods, func(i, j int) bool {
+			if methods[i].Receiver != methods[j].Receiver {
+				return methods[i].Receiver < methods[j].Receiver
+			}
+			return methods[i].Name < methods[j].Name
+		})
+		if err := dump(pkgName, methods); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+// process returns the exported manager methods whose last parameter is a
+// variadic list of request options.
+func process(fset *token.FileSet, f *ast.File) (methods []method) {
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || !fd.Name.IsExported() || strings.HasSuffix(fd.Name.Name, "Context") {
+			continue
+		}
+		star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		receiver, ok := star.X.(*ast.Ident)
+		if !ok || !strings.HasSuffix(receiver.Name, "Manager") {
+			continue
+		}
+		params := fd.Type.Params.List
+		if len(params) == 0 {
+			continue
+		}
+		last, ok := params[len(params)-1].Type.(*ast.Ellipsis)
+		if !ok {
+			continue
+		}
+		if ident, ok := last.Elt.(*ast.Ident); !ok || ident.Name != "RequestOption" {
+			continue
+		}
+
+		m := method{Receiver: receiver.Name, Name: fd.Name.Name}
+		var ps, as []string
+		for _, p := range params {
+			for _, name := range p.Names {
+				ps = append(ps, name.Name+" "+expr(fset, p.Type))
+				as = append(as, name.Name)
+			}
+		}
+		as[len(as)-1] = "withContext(ctx, " + as[len(as)-1] + ")..."
+		m.Params = strings.Join(ps, ", ")
+		m.Args = strings.Join(as, ", ")
+
+		if fd.Type.Results != nil {
+			var rs []string
+			for _, r := range fd.Type.Results.List {
+				n := len(r.Names)
+				if n == 0 {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					rs = append(rs, expr(fset, r.Type))
+				}
+			}
+			m.Results = strings.Join(rs, ", ")
+			if len(rs) > 1 {
+				m.Results = "(" + m.Results + ")"
+			}
+		}
+
+		logf("Adding %v.%vContext...", m.Receiver, m.Name)
+		methods = append(methods, m)
+	}
+	return methods
+}
+
+func expr(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, e)
+	return buf.String()
+}
+
+func filter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), suffix)
+}
+
+func dump(pkgName string, methods []method) error {
+	if len(methods) == 0 {
+		logf("No methods for %v; skipping.", pkgName)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	err := template.Must(template.New("source").Parse(source)).Execute(&buf, struct {
+		Package string
+		Methods []method
+	}{pkgName, methods})
+	if err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logf("Writing %v...", output)
+	return ioutil.WriteFile(output, clean, 0644)
+}
+
+const source = `// Code generated by gen-context; DO NOT EDIT.
+
+package {{.Package}}
+
+import "context"
+{{range .Methods}}
+// {{.Name}}Context is like {{.Name}}, but sends its requests using ctx.
+func (m *{{.Receiver}}) {{.Name}}Context(ctx context.Context, {{.Params}}) {{.Results}} {
+	{{if .Results}}return {{end}}m.{{.Name}}({{.Args}})
+}
+{{end}}`
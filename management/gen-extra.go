@@ -0,0 +1,190 @@
+//go:build ignore
+// +build ignore
+
+// Generates JSON marshalers keeping the properties unknown to structs with an
+// Extra field, such as Client, so that they are sent back on updates.
+//
+// Structs implementing either json.Marshaler or json.Unmarshaler already are
+// skipped, and are expected to use marshalExtra and unmarshalExtra
+// themselves.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	output = "extra.gen.go"
+	suffix = ".gen.go"
+)
+
+var verbose = flag.Bool("v", false, "Print verbose log messages")
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+type marshaler struct {
+	ReceiverVar  string
+	ReceiverType string
+	Alias        string
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", filter, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for pkgName, pkg := range pkgs {
+		var types []string
+		custom := make(map[string]bool)
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			types = append(types, structs(f)...)
+			for _, t := range marshalers(f) {
+				custom[t] = true
+			}
+		}
+
+		var ms []marshaler
+		for _, t := range types {
+			if custom[t] {
+				logf("Struct %v has custom marshalers; skipping.", t)
+				continue
+			}
+			ms = append(ms, marshaler{
+				ReceiverVar:  strings.ToLower(t[:1]),
+				ReceiverType: t,
+				Alias:        strings.ToLower(t[:1]) + t[1:],
+			})
+		}
+		sort.Slice(ms, func(i, j int) bool {
+			return ms[i].ReceiverType < ms[j].ReceiverType
+		})
+		if err := dump(pkgName, ms); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+// structs returns the exported structs with an Extra field.
+func structs(f *ast.File) (types []string) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				if len(field.Names) == 1 && field.Names[0].Name == "Extra" {
+					types = append(types, ts.Name.Name)
+				}
+			}
+		}
+	}
+	return types
+}
+
+// marshalers returns the types implementing MarshalJSON or UnmarshalJSON.
+func marshalers(f *ast.File) (types []string) {
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil {
+			continue
+		}
+		if fd.Name.Name != "MarshalJSON" && fd.Name.Name != "UnmarshalJSON" {
+			continue
+		}
+		recv := fd.Recv.List[0].Type
+		if star, ok := recv.(*ast.StarExpr); ok {
+			recv = star.X
+		}
+		if ident, ok := recv.(*ast.Ident); ok {
+			types = append(types, ident.Name)
+		}
+	}
+	return types
+}
+
+func filter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), suffix)
+}
+
+func dump(pkgName string, ms []marshaler) error {
+	if len(ms) == 0 {
+		logf("No marshalers for %v; skipping.", pkgName)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	err := template.Must(template.New("source").Parse(source)).Execute(&buf, struct {
+		Package    string
+		Marshalers []marshaler
+	}{pkgName, ms})
+	if err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logf("Writing %v...", output)
+	return ioutil.WriteFile(output, clean, 0644)
+}
+
+const source = `// Code generated by gen-extra; DO NOT EDIT.
+
+package {{.Package}}
+
+import "encoding/json"
+{{range .Marshalers}}
+// MarshalJSON implements the json.Marshaler interface, including the
+// properties held by Extra.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) MarshalJSON() ([]byte, error) {
+	type {{.Alias}} {{.ReceiverType}}
+	data, err := json.Marshal((*{{.Alias}})({{.ReceiverVar}}))
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(data, {{.ReceiverVar}}.Extra)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keeping unknown
+// properties in Extra.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) UnmarshalJSON(data []byte) error {
+	type {{.Alias}} {{.ReceiverType}}
+	err := json.Unmarshal(data, (*{{.Alias}})({{.ReceiverVar}}))
+	if err != nil {
+		return err
+	}
+	{{.ReceiverVar}}.Extra, err = unmarshalExtra(data, (*{{.Alias}})({{.ReceiverVar}}))
+	return err
+}
+{{end}}`
@@ -40,6 +40,15 @@ var (
 	blacklist = []string{
 		`Management`,
 		`.*Manager`,
+		`.*Iterator`,
+		`^Bulk`,
+		`^Change$`,
+		`^Plan$`,
+		`^Pool$`,
+		`^TenantDefinition$`,
+		`AuditSink$`,
+		`^UserExport$`,
+		`^UserImporter$`,
 	}
 )
 
@@ -114,6 +123,11 @@ func (t *templateData) process(f *ast.File) error {
 			// Add stringer method
 			t.addStringer(ts.Name.String())
 
+			// Add clone, equal and diff methods to resources
+			if isResource(ts.Name.String(), st) {
+				t.addComparer(ts.Name.String())
+			}
+
 			// Add accessor for each field
 			for _, field := range st.Fields.List {
 				se, ok := field.Type.(*ast.StarExpr)
@@ -151,6 +165,19 @@ func (t *templateData) process(f *ast.File) error {
 	return nil
 }
 
+// isResource reports whether the struct describes a resource, which holds the
+// properties unknown to the SDK in an Extra field, rather than a list, an
+// error or a type of the client itself.
+func isResource(name string, st *ast.StructType) bool {
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 1 && field.Names[0].Name == "Extra" {
+			return true
+		}
+	}
+	logf("Struct %v is not a resource; skipping comparer.", name)
+	return false
+}
+
 func filter(fi os.FileInfo) bool {
 	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), suffix)
 }
@@ -200,6 +227,15 @@ func (t *templateData) addStringer(receiverType string) {
 	})
 }
 
+func (t *templateData) addComparer(receiverType string) {
+	t.Getters = append(t.Getters, &getter{
+		sortVal:      strings.ToLower(receiverType) + ".zzy",
+		ReceiverVar:  strings.ToLower(receiverType[:1]),
+		ReceiverType: receiverType,
+		Comparer:     true,
+	})
+}
+
 func (t *templateData) addArrayType(x *ast.ArrayType, receiverType, fieldName string) {
 	var eltType string
 	switch elt := x.Elt.(type) {
@@ -304,6 +340,7 @@ type getter struct {
 	ZeroValue    string
 	NamedStruct  bool // Getter for named struct.
 	Stringer     bool // Used for the structs String method.
+	Comparer     bool // Used for the structs Clone, Equal and Diff methods.
 }
 
 type byName []*getter
@@ -331,6 +368,21 @@ func ({{.ReceiverVar}} *{{.ReceiverType}}) Get{{.FieldName}}() *{{.FieldType}} {
   }
   return {{.ReceiverVar}}.{{.FieldName}}
 }
+{{else if .Comparer}}
+// Clone returns a deep copy of {{.ReceiverType}}.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Clone() *{{.ReceiverType}} {
+  return clone({{.ReceiverVar}}).(*{{.ReceiverType}})
+}
+
+// Equal reports whether {{.ReceiverType}} holds the same values as other.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Equal(other *{{.ReceiverType}}) bool {
+  return len({{.ReceiverVar}}.Diff(other)) == 0
+}
+
+// Diff returns the properties of {{.ReceiverType}} which differ from other.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Diff(other *{{.ReceiverType}}) []Change {
+  return diff({{.ReceiverVar}}, other)
+}
 {{else if .Stringer}}
 // String returns a string representation of {{.ReceiverType}}.
 func ({{.ReceiverVar}} *{{.ReceiverType}}) String() string {
@@ -0,0 +1,138 @@
+//go:build ignore
+// +build ignore
+
+// Generates the set of static path segments of the Management API endpoints
+// used by the managers, which are the string literals passed to
+// Management.URI, so that resource identifiers can be told apart from them.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	output = "paths.gen.go"
+	suffix = ".gen.go"
+)
+
+var verbose = flag.Bool("v", false, "Print verbose log messages")
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", filter, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for pkgName, pkg := range pkgs {
+		segments := make(map[string]bool)
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			process(f, segments)
+		}
+		var sorted []string
+		for s := range segments {
+			sorted = append(sorted, s)
+		}
+		sort.Strings(sorted)
+		if err := dump(pkgName, sorted); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+// process adds the segments of the string literals passed to URI, and of the
+// base path, to segments.
+func process(f *ast.File, segments map[string]bool) {
+	add := func(e ast.Expr) {
+		lit, ok := e.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return
+		}
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return
+		}
+		for _, segment := range strings.Split(s, "/") {
+			if segment != "" {
+				segments[segment] = true
+			}
+		}
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "URI" {
+				for _, arg := range n.Args {
+					add(arg)
+				}
+			}
+		case *ast.KeyValueExpr:
+			if key, ok := n.Key.(*ast.Ident); ok && key.Name == "basePath" {
+				add(n.Value)
+			}
+		}
+		return true
+	})
+}
+
+func filter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), suffix)
+}
+
+func dump(pkgName string, segments []string) error {
+	if len(segments) == 0 {
+		logf("No path segments for %v; skipping.", pkgName)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	err := template.Must(template.New("source").Parse(source)).Execute(&buf, struct {
+		Package  string
+		Segments []string
+	}{pkgName, segments})
+	if err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logf("Writing %v...", output)
+	return ioutil.WriteFile(output, clean, 0644)
+}
+
+const source = `// Code generated by gen-paths; DO NOT EDIT.
+
+package {{.Package}}
+
+// pathSegments are the static path segments of the Management API endpoints
+// used by the managers. Any other segment is a resource identifier.
+var pathSegments = map[string]bool{
+{{- range .Segments}}
+	{{printf "%q" .}}: true,
+{{- end}}
+}
+`
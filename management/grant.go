@@ -22,6 +22,20 @@ type GrantList struct {
 	Grants []*Grant `json:"grants"`
 }
 
+// GrantIterator iterates over grants, fetching further pages as needed.
+type GrantIterator struct {
+	it *iterator
+}
+
+// Next returns the next grant. It returns Done when there are no more grants.
+func (i *GrantIterator) Next() (*Grant, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Grant), nil
+}
+
 type GrantManager struct {
 	*Management
 }
@@ -34,12 +48,20 @@ func newGrantManager(m *Management) *GrantManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Grants/get_grants
 func (m *GrantManager) List(opts ...RequestOption) (g *GrantList, err error) {
-	err = m.Request("GET", m.URI("grants"), &g, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("grants"), &g, applyListDefaults(withOperation("Grant.List", opts)))
 	return
 }
 
+// Iterate returns an iterator over the grants associated with your account,
+// fetching further pages as the iteration advances.
+func (m *GrantManager) Iterate(opts ...RequestOption) *GrantIterator {
+	return &GrantIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
 // Delete revokes a grant associated with a user-id
 // https://auth0.com/docs/api/management/v2#!/Grants/delete_grants_by_id
 func (m *GrantManager) Delete(id string, opts ...RequestOption) error {
-	return m.Request("DELETE", m.URI("grants", id), nil, opts...)
+	return m.Request("DELETE", m.URI("grants", id), nil, withOperation("Grant.Delete", opts)...)
 }
@@ -0,0 +1,140 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is the reason of the GuardError returned when a client
+// configured using WithReadOnly is used to modify resources.
+var ErrReadOnly = errors.New("read-only client")
+
+// GuardError is the error returned when a request modifying resources is
+// rejected before being sent, either because the client is read-only or by a
+// guard rule. The reason can be checked using errors.Is, for example with
+// ErrReadOnly.
+type GuardError struct {
+	// The logical operation, such as "Client.Delete", if known.
+	Operation string
+
+	// The method and URL of the request which was rejected.
+	Method string
+	URL    string
+
+	// The reason the request was rejected.
+	Err error
+}
+
+func (e *GuardError) Error() string {
+	op := e.Operation
+	if op == "" {
+		op = e.Method + " " + e.URL
+	}
+	return fmt.Sprintf("%s rejected: %s", op, e.Err)
+}
+
+// Unwrap returns the reason the request was rejected.
+func (e *GuardError) Unwrap() error {
+	return e.Err
+}
+
+// Mutation describes a request modifying resources, which guard rules check
+// before it is sent.
+type Mutation struct {
+	// The logical operation, made of the manager and method names, such as
+	// "Client.Delete". Empty when the request was not sent by a manager, nor
+	// named using Operation.
+	Operation string
+
+	// The HTTP method of the request.
+	Method string
+
+	// The path of the request, such as "/api/v2/clients/{id}" with the actual
+	// client id.
+	Path string
+
+	// The value sent as the request payload, such as a *Client, or nil.
+	Payload interface{}
+}
+
+// GuardRule checks a request modifying resources before it is sent, returning
+// an error to prevent it. Rules may use the management client to read the
+// resources concerned, for example to only protect clients with a given name.
+//
+//     func(ctx context.Context, m *management.Management, mut management.Mutation) error {
+//         if mut.Operation != "Client.Delete" {
+//             return nil
+//         }
+//         c, err := m.Client.ReadContext(ctx, path.Base(mut.Path))
+//         if err != nil {
+//             return err
+//         }
+//         if strings.HasPrefix(c.GetName(), "prod-") {
+//             return errors.New("production clients must not be deleted")
+//         }
+//         return nil
+//     }
+type GuardRule func(ctx context.Context, m *Management, mut Mutation) error
+
+// WithReadOnly configures the management client to reject any request other
+// than GET, HEAD or OPTIONS with a GuardError, without sending it.
+func WithReadOnly() ManagementOption {
+	return func(m *Management) {
+		m.readOnly = true
+	}
+}
+
+// WithGuardRules configures the management client to check requests
+// modifying resources using the rules, in order, before they are sent. A
+// request is rejected with a GuardError wrapping the error returned by the
+// first rule failing.
+func WithGuardRules(rules ...GuardRule) ManagementOption {
+	return func(m *Management) {
+		m.guardRules = append(m.guardRules, rules...)
+	}
+}
+
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// guard checks whether the request is allowed to be sent.
+func (m *Management) guard(req *http.Request) error {
+	if !isMutation(req.Method) || (!m.readOnly && len(m.guardRules) == 0) {
+		return nil
+	}
+
+	mut := Mutation{
+		Operation: operation(req),
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		Payload:   req.Context().Value(payloadKey{}),
+	}
+	reject := func(err error) error {
+		return &GuardError{mut.Operation, req.Method, req.URL.String(), err}
+	}
+
+	if m.readOnly {
+		return reject(ErrReadOnly)
+	}
+	for _, rule := range m.guardRules {
+		if err := rule(req.Context(), m, mut); err != nil {
+			return reject(err)
+		}
+	}
+	return nil
+}
+
+type payloadKey struct{}
+
+// withPayload returns a copy of req carrying the value it sends, which guard
+// rules are given.
+func withPayload(req *http.Request, v interface{}) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), payloadKey{}, v))
+}
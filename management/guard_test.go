@@ -0,0 +1,143 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestMutationGuard(t *testing.T) {
+
+	var sent []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Write([]byte(`{"client_id":"` + path.Base(r.URL.Path) + `","name":"` + path.Base(r.URL.Path) + `"}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		sent = nil
+
+		m, err := New(s.URL, WithInsecure(), WithReadOnly())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := m.Client.Read("app"); err != nil {
+			t.Fatal(err)
+		}
+
+		err = m.Client.Delete("app")
+		if !errors.Is(err, ErrReadOnly) {
+			t.Fatalf("Expected err to be ErrReadOnly, got %v", err)
+		}
+		var guardErr *GuardError
+		if !errors.As(err, &guardErr) {
+			t.Fatalf("Expected err to be a *GuardError, got %T", err)
+		}
+		expect.Expect(t, guardErr.Operation, "Client.Delete")
+		expect.Expect(t, guardErr.Method, http.MethodDelete)
+
+		req, _ := m.NewRequest(http.MethodPost, m.URI("clients"), nil)
+		if _, err := m.Do(req); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("Expected Do to reject the request, got %v", err)
+		}
+
+		expect.Expect(t, sent, []string{"GET /api/v2/clients/app"})
+	})
+
+	t.Run("GuardRules", func(t *testing.T) {
+		sent = nil
+
+		errProduction := errors.New("production clients must not be deleted")
+		var mutations []Mutation
+
+		m, err := New(s.URL, WithInsecure(), WithGuardRules(
+			func(ctx context.Context, m *Management, mut Mutation) error {
+				mutations = append(mutations, mut)
+				return nil
+			},
+			func(ctx context.Context, m *Management, mut Mutation) error {
+				if mut.Operation != "Client.Delete" {
+					return nil
+				}
+				c, err := m.Client.ReadContext(ctx, path.Base(mut.Path))
+				if err != nil {
+					return err
+				}
+				if strings.HasPrefix(c.GetName(), "prod-") {
+					return errProduction
+				}
+				return nil
+			},
+		))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := m.Client.Delete("prod-app"); !errors.Is(err, errProduction) {
+			t.Errorf("Expected err to be errProduction, got %v", err)
+		}
+		if err := m.Client.Delete("dev-app"); err != nil {
+			t.Error(err)
+		}
+		c := &Client{Name: auth0.String("app")}
+		if err := m.Client.Update("dev-app", c); err != nil {
+			t.Error(err)
+		}
+
+		expect.Expect(t, len(mutations), 3)
+		expect.Expect(t, mutations[0].Path, "/api/v2/clients/prod-app")
+		expect.Expect(t, mutations[2].Operation, "Client.Update")
+		expect.Expect(t, mutations[2].Payload, c)
+		expect.Expect(t, sent, []string{
+			"GET /api/v2/clients/prod-app",
+			"GET /api/v2/clients/dev-app",
+			"DELETE /api/v2/clients/dev-app",
+			"PATCH /api/v2/clients/dev-app",
+		})
+	})
+
+	t.Run("ImportUsers", func(t *testing.T) {
+		sent = nil
+
+		errImports := errors.New("imports are disabled")
+		var mutations []Mutation
+
+		m, err := New(s.URL, WithInsecure(), WithGuardRules(
+			func(ctx context.Context, m *Management, mut Mutation) error {
+				mutations = append(mutations, mut)
+				return errImports
+			},
+		))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		j := &Job{
+			ConnectionID: auth0.String("con_123"),
+			Users:        []map[string]interface{}{{"email": "jane@example.com"}},
+		}
+		if err := m.Job.ImportUsers(j); !errors.Is(err, errImports) {
+			t.Errorf("Expected err to be errImports, got %v", err)
+		}
+
+		expect.Expect(t, len(mutations), 1)
+		expect.Expect(t, mutations[0].Operation, "Job.ImportUsers")
+		expect.Expect(t, mutations[0].Payload, j)
+		expect.Expect(t, len(sent), 0)
+	})
+}
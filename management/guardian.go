@@ -50,6 +50,9 @@ type MultiFactorSMSTemplate struct {
 
 	// Message sent to the user when they are prompted to verify their account
 	VerificationMessage *string `json:"verification_message,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type MultiFactorProviderAmazonSNS struct {
@@ -67,6 +70,9 @@ type MultiFactorProviderAmazonSNS struct {
 
 	// SNS GCM Platform Application ARN
 	GCMPlatformApplicationARN *string `json:"sns_gcm_platform_application_arn,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type MultiFactorProviderTwilio struct {
@@ -81,6 +87,9 @@ type MultiFactorProviderTwilio struct {
 
 	// Twilio SID
 	SID *string `json:"sid,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type GuardianManager struct {
@@ -130,19 +139,19 @@ type EnrollmentTicket struct {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Guardian/post_ticket
 func (m *EnrollmentManager) CreateTicket(t *CreateEnrollmentTicket, opts ...RequestOption) (EnrollmentTicket, error) {
-	req, err := m.NewRequest("POST", m.URI("guardian", "enrollments", "ticket"), t, opts...)
+	req, err := m.NewRequest("POST", m.URI("guardian", "enrollments", "ticket"), t, withOperation("Enrollment.CreateTicket", opts)...)
 	if err != nil {
 		return EnrollmentTicket{}, err
 	}
 
-	res, err := m.Do(req)
+	res, err := m.Do(withPayload(req, t))
 	if err != nil {
 		return EnrollmentTicket{}, err
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		return EnrollmentTicket{}, newError(res.Body)
+		return EnrollmentTicket{}, newError(res)
 	}
 
 	var out EnrollmentTicket
@@ -154,7 +163,7 @@ func (m *EnrollmentManager) CreateTicket(t *CreateEnrollmentTicket, opts ...Requ
 //
 // See: https://auth0.com/docs/api/management/v2#!/Guardian/get_enrollments_by_id
 func (m *EnrollmentManager) Get(id string, opts ...RequestOption) (en *Enrollment, err error) {
-	err = m.Request("GET", m.URI("guardian", "enrollments", id), &en, opts...)
+	err = m.Request("GET", m.URI("guardian", "enrollments", id), &en, withOperation("Enrollment.Get", opts)...)
 	return
 }
 
@@ -162,7 +171,7 @@ func (m *EnrollmentManager) Get(id string, opts ...RequestOption) (en *Enrollmen
 //
 // See: https://auth0.com/docs/api/management/v2#!/Guardian/delete_enrollments_by_id
 func (m *EnrollmentManager) Delete(id string, opts ...RequestOption) (err error) {
-	err = m.Request("DELETE", m.URI("guardian", "enrollments", id), nil, opts...)
+	err = m.Request("DELETE", m.URI("guardian", "enrollments", id), nil, withOperation("Enrollment.Delete", opts)...)
 	return
 }
 
@@ -182,7 +191,7 @@ type MultiFactorManager struct {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Guardian/get_factors
 func (m *MultiFactorManager) List(opts ...RequestOption) (mf []*MultiFactor, err error) {
-	err = m.Request("GET", m.URI("guardian", "factors"), &mf, opts...)
+	err = m.Request("GET", m.URI("guardian", "factors"), &mf, withOperation("MultiFactor.List", opts)...)
 	return
 }
 
@@ -190,7 +199,7 @@ func (m *MultiFactorManager) List(opts ...RequestOption) (mf []*MultiFactor, err
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Guardian/get_policies
 func (m *MultiFactorManager) Policy(opts ...RequestOption) (p *MultiFactorPolicies, err error) {
-	err = m.Request("GET", m.URI("guardian", "policies"), &p, opts...)
+	err = m.Request("GET", m.URI("guardian", "policies"), &p, withOperation("MultiFactor.Policy", opts)...)
 	return
 }
 
@@ -199,7 +208,7 @@ func (m *MultiFactorManager) Policy(opts ...RequestOption) (p *MultiFactorPolici
 // See: https://auth0.com/docs/api/management/v2/#!/Guardian/put_policies
 //Expects an array of either ["all-applications"] or ["confidence-score"]
 func (m *MultiFactorManager) UpdatePolicy(p *MultiFactorPolicies, opts ...RequestOption) error {
-	return m.Request("PUT", m.URI("guardian", "policies"), p, opts...)
+	return m.Request("PUT", m.URI("guardian", "policies"), p, withOperation("MultiFactor.UpdatePolicy", opts)...)
 }
 
 type MultiFactorPhone struct{ *Management }
@@ -23,6 +23,9 @@ type Hook struct {
 
 	// Enabled should be set to true if the hook is enabled, false otherwise.
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type HookList struct {
@@ -30,6 +33,20 @@ type HookList struct {
 	Hooks []*Hook `json:"hooks"`
 }
 
+// HookIterator iterates over hooks, fetching further pages as needed.
+type HookIterator struct {
+	it *iterator
+}
+
+// Next returns the next hook. It returns Done when there are no more hooks.
+func (i *HookIterator) Next() (*Hook, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Hook), nil
+}
+
 // HookSecrets are the secret keys and values associated with a hook
 type HookSecrets map[string]string
 
@@ -82,14 +99,14 @@ func newHookManager(m *Management) *HookManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Hooks/post_hooks
 func (m *HookManager) Create(h *Hook, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("hooks"), h, opts...)
+	return m.Request("POST", m.URI("hooks"), h, withOperation("Hook.Create", opts)...)
 }
 
 // Retrieve hook details. Accepts a list of fields to include or exclude in the result.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Hooks/get_hooks_by_id
 func (m *HookManager) Read(id string, opts ...RequestOption) (h *Hook, err error) {
-	err = m.Request("GET", m.URI("hooks", id), &h, opts...)
+	err = m.Request("GET", m.URI("hooks", id), &h, withOperation("Hook.Read", opts)...)
 	return
 }
 
@@ -97,37 +114,45 @@ func (m *HookManager) Read(id string, opts ...RequestOption) (h *Hook, err error
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Hooks/patch_hooks_by_id
 func (m *HookManager) Update(id string, h *Hook, opts ...RequestOption) error {
-	return m.Request("PATCH", m.URI("hooks", id), h, opts...)
+	return m.Request("PATCH", m.URI("hooks", id), h, withOperation("Hook.Update", opts)...)
 }
 
 // Delete a hook.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Hooks/delete_hooks_by_id
 func (m *HookManager) Delete(id string, opts ...RequestOption) error {
-	return m.Request("DELETE", m.URI("hooks", id), nil, opts...)
+	return m.Request("DELETE", m.URI("hooks", id), nil, withOperation("Hook.Delete", opts)...)
 }
 
 // List all hooks.
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Hooks/get_hooks
 func (m *HookManager) List(opts ...RequestOption) (l *HookList, err error) {
-	err = m.Request("GET", m.URI("hooks"), &l, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("hooks"), &l, applyListDefaults(withOperation("Hook.List", opts)))
 	return
 }
 
+// Iterate returns an iterator over all hooks, fetching further pages as the
+// iteration advances.
+func (m *HookManager) Iterate(opts ...RequestOption) *HookIterator {
+	return &HookIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
 // CreateSecrets adds one or more secrets to an existing hook. A hook can have a
 // maximum of 20 secrets.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Hooks/post_secrets
 func (m *HookManager) CreateSecrets(hookID string, s HookSecrets, opts ...RequestOption) (err error) {
-	return m.Request("POST", m.URI("hooks", hookID, "secrets"), &s, opts...)
+	return m.Request("POST", m.URI("hooks", hookID, "secrets"), &s, withOperation("Hook.CreateSecrets", opts)...)
 }
 
 // UpdateSecrets updates one or more existing secrets for an existing hook.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Hooks/patch_secrets
 func (m *HookManager) UpdateSecrets(hookID string, s HookSecrets, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("hooks", hookID, "secrets"), &s, opts...)
+	return m.Request("PATCH", m.URI("hooks", hookID, "secrets"), &s, withOperation("Hook.UpdateSecrets", opts)...)
 }
 
 // ReplaceSecrets replaces existing secrets with the provided ones.
@@ -159,7 +184,7 @@ func (m *HookManager) ReplaceSecrets(hookID string, s HookSecrets, opts ...Reque
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Hooks/get_secrets
 func (m *HookManager) Secrets(hookID string, opts ...RequestOption) (s HookSecrets, err error) {
-	err = m.Request("GET", m.URI("hooks", hookID, "secrets"), &s, opts...)
+	err = m.Request("GET", m.URI("hooks", hookID, "secrets"), &s, withOperation("Hook.Secrets", opts)...)
 	return
 }
 
@@ -168,18 +193,18 @@ func (m *HookManager) Secrets(hookID string, opts ...RequestOption) (s HookSecre
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Hooks/delete_secrets
 func (m *HookManager) RemoveSecrets(hookID string, keys []string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("hooks", hookID, "secrets"), keys, opts...)
+	return m.Request("DELETE", m.URI("hooks", hookID, "secrets"), keys, withOperation("Hook.RemoveSecrets", opts)...)
 }
 
 // RemoveAllSecrets removes all secrets associated with a given hook.
 func (m *HookManager) RemoveAllSecrets(hookID string, opts ...RequestOption) (err error) {
-	s, err := m.Secrets(hookID)
+	s, err := m.Secrets(hookID, opts...)
 	if err != nil {
 		return err
 	}
 	keys := s.Keys()
 	if len(keys) > 0 {
-		err = m.RemoveSecrets(hookID, keys)
+		err = m.RemoveSecrets(hookID, keys, opts...)
 	}
 	return err
 }
@@ -0,0 +1,168 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+)
+
+// Done is returned by an iterator's Next method when the iteration is
+// complete.
+var Done = errors.New("no more items in iterator")
+
+// iterator lazily fetches pages of a list endpoint and hands out their items
+// one at a time. It is wrapped by the typed iterators of each resource, such
+// as UserIterator.
+//
+// By default pages are requested using offset pagination, incrementing the
+// page query parameter until the list reports there are no further pages.
+// Endpoints which require checkpoint pagination instead provide a checkpoint
+// function, which returns the value of the from query parameter used to
+// request the next page, or "" if the last page was reached.
+type iterator struct {
+	ctx        context.Context
+	opts       []RequestOption
+	fetch      func(opts ...RequestOption) (interface{}, error)
+	checkpoint func(l List, items []interface{}) string
+
+	page  int
+	from  string
+	items []interface{}
+	last  bool
+	err   error
+}
+
+// itemIterator hands out items one at a time like iterator, so that typed
+// iterators can be backed by other ways of fetching items.
+type itemIterator interface {
+	next() (interface{}, error)
+}
+
+func newIterator(opts []RequestOption, fetch func(opts ...RequestOption) (interface{}, error)) *iterator {
+	return &iterator{
+		ctx:   requestContext(opts),
+		opts:  opts,
+		fetch: fetch,
+	}
+}
+
+func newCheckpointIterator(opts []RequestOption, fetch func(opts ...RequestOption) (interface{}, error), checkpoint func(l List, items []interface{}) string) *iterator {
+	it := newIterator(opts, fetch)
+	it.checkpoint = checkpoint
+	return it
+}
+
+// next returns the next item, fetching the next page if the current one has
+// been exhausted. Once next returns an error, all subsequent calls return the
+// same error.
+func (it *iterator) next() (interface{}, error) {
+	for len(it.items) == 0 {
+		if it.err != nil {
+			return nil, it.err
+		}
+		if it.last {
+			return nil, Done
+		}
+		it.fetchNext()
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return nil, err
+	}
+	v := it.items[0]
+	it.items = it.items[1:]
+	return v, nil
+}
+
+func (it *iterator) fetchNext() {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return
+	}
+
+	var opts []RequestOption
+	if it.checkpoint != nil {
+		opts = append(opts, Take(50))
+		opts = append(opts, it.opts...)
+		if it.from != "" {
+			opts = append(opts, From(it.from))
+		}
+	} else {
+		opts = append(opts, it.opts...)
+		opts = append(opts, Page(it.page))
+	}
+
+	v, err := it.fetch(opts...)
+	if err != nil {
+		it.err = err
+		return
+	}
+	items, l := pageOf(v)
+	it.items = items
+
+	if it.checkpoint != nil {
+		it.from = it.checkpoint(l, items)
+		it.last = len(items) == 0 || it.from == ""
+		return
+	}
+	it.page++
+	it.last = len(items) == 0 || !l.HasNext()
+}
+
+// pageOf returns the items and list metadata of a page returned by a List
+// method. The page is either a slice, or a pointer to a struct embedding List
+// and holding the items in a slice field.
+func pageOf(v interface{}) (items []interface{}, l List) {
+	val := reflect.Indirect(reflect.ValueOf(v))
+
+	var s reflect.Value
+	switch val.Kind() {
+	case reflect.Slice:
+		s = val
+	case reflect.Struct:
+		typ := val.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := val.Field(i)
+			switch {
+			case typ.Field(i).Anonymous && field.Type() == reflect.TypeOf(List{}):
+				l = field.Interface().(List)
+			case field.Kind() == reflect.Slice:
+				s = field
+			}
+		}
+	}
+
+	if s.IsValid() {
+		for i := 0; i < s.Len(); i++ {
+			items = append(items, s.Index(i).Interface())
+		}
+	}
+	return
+}
+
+// checkpointNext is a checkpoint function for endpoints which return the
+// checkpoint of the next page in the next field of the response.
+func checkpointNext(l List, _ []interface{}) string {
+	return l.Next
+}
+
+// requestContext returns the context a request would be configured with by
+// the given options.
+func requestContext(opts []RequestOption) context.Context {
+	return optionsRequest(opts).Context()
+}
+
+// requestQuery returns the value of the query parameter a request would be
+// configured with by the given options.
+func requestQuery(opts []RequestOption, key string) string {
+	return optionsRequest(opts).URL.Query().Get(key)
+}
+
+func optionsRequest(opts []RequestOption) *http.Request {
+	r, _ := http.NewRequest("GET", "", nil)
+	for _, option := range opts {
+		option.apply(r)
+	}
+	return r
+}
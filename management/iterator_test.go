@@ -0,0 +1,125 @@
+package management_test
+
+import (
+	"context"
+	"testing"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+	"gopkg.in/auth0.v5/management"
+	"gopkg.in/auth0.v5/management/managementtest"
+)
+
+func TestIterator(t *testing.T) {
+	s := managementtest.NewServer()
+	defer s.Close()
+
+	m, err := s.Management()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		err := m.Role.Create(&management.Role{Name: auth0.Stringf("role-%d", i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("Offset", func(t *testing.T) {
+		var names []string
+		it := m.Role.Iterate(management.PerPage(2))
+		for {
+			r, err := it.Next()
+			if err == management.Done {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			names = append(names, r.GetName())
+		}
+		expect.Expect(t, names, []string{"role-0", "role-1", "role-2", "role-3", "role-4"})
+
+		_, err := it.Next()
+		expect.Expect(t, err, management.Done)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		_, err := m.Client.Iterate().Next()
+		expect.Expect(t, err, management.Done)
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		it := m.Role.Iterate(management.Context(ctx), management.PerPage(2))
+
+		_, err := it.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cancel()
+
+		_, err = it.Next()
+		expect.Expect(t, err, context.Canceled)
+	})
+
+	t.Run("Checkpoint", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			s.AddLogs(&management.Log{LogID: auth0.Stringf("log-%d", i)})
+		}
+
+		var ids []string
+		it := m.Log.Iterate(management.Take(2))
+		for {
+			l, err := it.Next()
+			if err == management.Done {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, l.GetLogID())
+		}
+		expect.Expect(t, ids, []string{"log-0", "log-1", "log-2", "log-3", "log-4"})
+	})
+
+	t.Run("CheckpointNext", func(t *testing.T) {
+		c := &management.Connection{
+			Name:     auth0.String("Username-Password-Authentication"),
+			Strategy: auth0.String("auth0"),
+		}
+		if err := m.Connection.Create(c); err != nil {
+			t.Fatal(err)
+		}
+		o := &management.Organization{Name: auth0.String("acme")}
+		if err := m.Organization.Create(o); err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for i := 0; i < 3; i++ {
+			u := &management.User{Connection: c.Name, Email: auth0.Stringf("user-%d@example.com", i)}
+			if err := m.User.Create(u); err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, u.GetID())
+		}
+		if err := m.Organization.AddMembers(o.GetID(), ids); err != nil {
+			t.Fatal(err)
+		}
+
+		var emails []string
+		it := m.Organization.IterateMembers(o.GetID(), management.Take(2))
+		for {
+			u, err := it.Next()
+			if err == management.Done {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			emails = append(emails, u.GetEmail())
+		}
+		expect.Expect(t, emails, []string{"user-0@example.com", "user-1@example.com", "user-2@example.com"})
+	})
+}
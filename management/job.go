@@ -3,6 +3,8 @@ package management
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
@@ -55,8 +57,29 @@ type Job struct {
 	// finished. The default is true, so you must explicitly set this parameter
 	// to false if you do not want emails sent.
 	SendCompletionEmail *bool `json:"send_completion_email,omitempty"`
+
+	// The number of users processed by an import job, once completed.
+	Summary *JobSummary `json:"summary,omitempty"`
+}
+
+// JobSummary counts the users processed by an import job.
+type JobSummary struct {
+	Failed   *int `json:"failed,omitempty"`
+	Updated  *int `json:"updated,omitempty"`
+	Inserted *int `json:"inserted,omitempty"`
+	Total    *int `json:"total,omitempty"`
 }
 
+// ErrJobFailed is returned by JobManager.Wait when the job failed.
+var ErrJobFailed = errors.New("job failed")
+
+// The delays between two reads of a job waited for, doubled after each read
+// up to the maximum.
+var (
+	jobPollDelay    = time.Second
+	jobPollMaxDelay = 30 * time.Second
+)
+
 type JobManager struct {
 	*Management
 }
@@ -66,22 +89,62 @@ func newJobManager(m *Management) *JobManager {
 }
 
 func (m *JobManager) VerifyEmail(j *Job, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("jobs", "verification-email"), j, opts...)
+	return m.Request("POST", m.URI("jobs", "verification-email"), j, withOperation("Job.VerifyEmail", opts)...)
 }
 
 // Retrieves a job. Useful to check its status.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Jobs/get_jobs_by_id
 func (m *JobManager) Read(id string, opts ...RequestOption) (j *Job, err error) {
-	err = m.Request("GET", m.URI("jobs", id), &j)
+	err = m.Request("GET", m.URI("jobs", id), &j, withOperation("Job.Read", opts)...)
 	return
 }
 
+// Wait blocks until the job, such as one returned by VerifyEmail,
+// ExportUsers or ImportUsers, is completed, reading it again less and less
+// frequently and updating j. It returns an error wrapping ErrJobFailed if the
+// job failed.
+//
+// Progress, when not nil, is called each time the job has been read, for
+// example to report its PercentageDone and TimeLeftSeconds.
+func (m *JobManager) Wait(j *Job, progress func(j *Job), opts ...RequestOption) error {
+	ctx := requestContext(opts)
+	delay := jobPollDelay
+	for {
+		switch j.GetStatus() {
+		case "completed":
+			return nil
+		case "failed":
+			return fmt.Errorf("%w: %s", ErrJobFailed, j.GetID())
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+		if delay *= 2; delay > jobPollMaxDelay {
+			delay = jobPollMaxDelay
+		}
+
+		read, err := m.Read(j.GetID(), opts...)
+		if err != nil {
+			return err
+		}
+		*j = *read
+		if progress != nil {
+			progress(j)
+		}
+	}
+}
+
 // Export all users to a file via a long-running job.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Jobs/post_users_exports
 func (m *JobManager) ExportUsers(j *Job, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("jobs", "users-exports"), j, opts...)
+	return m.Request("POST", m.URI("jobs", "users-exports"), j, withOperation("Job.ExportUsers", opts)...)
 }
 
 // Import users from a formatted file into a connection via a long-running job.
@@ -126,17 +189,17 @@ func (m *JobManager) ImportUsers(j *Job, opts ...RequestOption) error {
 	}
 	req.Header.Add("Content-Type", mp.FormDataContentType())
 
-	for _, option := range opts {
+	for _, option := range withOperation("Job.ImportUsers", opts) {
 		option.apply(req)
 	}
 
-	res, err := m.Do(req)
+	res, err := m.Do(withPayload(req, j))
 	if err != nil {
 		return err
 	}
 
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
-		return newError(res.Body)
+		return newError(res)
 	}
 
 	if res.StatusCode != http.StatusNoContent {
@@ -146,3 +209,43 @@ func (m *JobManager) ImportUsers(j *Job, opts ...RequestOption) error {
 
 	return nil
 }
+
+// JobError is a record which a job failed to process, such as a user which
+// could not be imported.
+type JobError struct {
+	// The record as submitted to the job.
+	User map[string]interface{} `json:"user,omitempty"`
+
+	// The reasons the record failed.
+	Errors []*JobErrorDetails `json:"errors,omitempty"`
+}
+
+// JobErrorDetails describes why a record failed to be processed by a job.
+type JobErrorDetails struct {
+	// The error code, such as "DUPLICATED_USER".
+	Code *string `json:"code,omitempty"`
+
+	// A description of the error.
+	Message *string `json:"message,omitempty"`
+
+	// The path of the offending property of the record, if any.
+	Path *string `json:"path,omitempty"`
+}
+
+// Errors retrieves the records which a job failed to process, such as the
+// users rejected by an import job.
+//
+// See: https://auth0.com/docs/api/management/v2#!/Jobs/get_errors
+func (m *JobManager) Errors(id string, opts ...RequestOption) (errs []*JobError, err error) {
+	var v json.RawMessage
+	err = m.Request("GET", m.URI("jobs", id, "errors"), &v, withOperation("Job.Errors", opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The job itself is returned instead of a list when it has no errors.
+	if b := bytes.TrimSpace(v); len(b) > 0 && b[0] == '[' {
+		err = json.Unmarshal(b, &errs)
+	}
+	return
+}
@@ -1,9 +1,16 @@
 package management
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
 )
 
 func TestJob(t *testing.T) {
@@ -92,3 +99,107 @@ func TestJob(t *testing.T) {
 	})
 
 }
+
+func TestJobErrors(t *testing.T) {
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/jobs/job_1/errors":
+			w.Write([]byte(`[{"user":{"email":"taken@example.com"},"errors":[{"code":"DUPLICATED_USER","message":"The user already exist","path":"email"}]}]`))
+		case "/api/v2/jobs/job_2/errors":
+			w.Write([]byte(`{"id":"job_2","status":"completed","type":"users_import"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := New(s.URL, WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs, err := m.Job.Errors("job_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, len(errs), 1)
+	expect.Expect(t, errs[0].User, map[string]interface{}{"email": "taken@example.com"})
+	expect.Expect(t, errs[0].Errors, []*JobErrorDetails{{
+		Code:    auth0.String("DUPLICATED_USER"),
+		Message: auth0.String("The user already exist"),
+		Path:    auth0.String("email"),
+	}})
+
+	// The job is returned instead when it has no errors.
+	errs, err = m.Job.Errors("job_2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, len(errs), 0)
+
+	if _, err := m.Job.Errors("job_3"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestJobWait(t *testing.T) {
+
+	defer func(delay time.Duration) { jobPollDelay = delay }(jobPollDelay)
+	jobPollDelay = time.Millisecond
+
+	var reads int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/jobs/job_1":
+			switch atomic.AddInt32(&reads, 1) {
+			case 1:
+				w.Write([]byte(`{"id":"job_1","status":"processing","percentage_done":40,"time_left_seconds":30}`))
+			default:
+				w.Write([]byte(`{"id":"job_1","status":"completed","percentage_done":100,"summary":{"failed":1,"inserted":2,"total":3}}`))
+			}
+		case "/api/v2/jobs/job_2":
+			w.Write([]byte(`{"id":"job_2","status":"failed"}`))
+		default:
+			w.Write([]byte(`{"id":"job_3","status":"pending"}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := New(s.URL, WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Completed", func(t *testing.T) {
+		var progress []int
+		j := &Job{ID: auth0.String("job_1"), Status: auth0.String("pending")}
+		err := m.Job.Wait(j, func(j *Job) {
+			progress = append(progress, j.GetPercentageDone())
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, progress, []int{40, 100})
+		expect.Expect(t, j.GetStatus(), "completed")
+		expect.Expect(t, j.GetSummary().GetFailed(), 1)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		err := m.Job.Wait(&Job{ID: auth0.String("job_2")}, nil)
+		if !errors.Is(err, ErrJobFailed) {
+			t.Errorf("Expected ErrJobFailed, got %v", err)
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := m.Job.WaitContext(ctx, &Job{ID: auth0.String("job_3")}, nil)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+}
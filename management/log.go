@@ -98,6 +98,21 @@ func (l *Log) TypeName() string {
 	return ""
 }
 
+// LogIterator iterates over log entries, fetching further pages as needed.
+type LogIterator struct {
+	it *iterator
+}
+
+// Next returns the next log entry. It returns Done when there are no more log
+// entries.
+func (i *LogIterator) Next() (*Log, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Log), nil
+}
+
 type LogManager struct {
 	*Management
 }
@@ -111,7 +126,7 @@ func newLogManager(m *Management) *LogManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Logs/get_logs_by_id
 func (m *LogManager) Read(id string, opts ...RequestOption) (l *Log, err error) {
-	err = m.Request("GET", m.URI("logs", id), &l, opts...)
+	err = m.Request("GET", m.URI("logs", id), &l, withOperation("Log.Read", opts)...)
 	return
 }
 
@@ -124,10 +139,26 @@ func (m *LogManager) Read(id string, opts ...RequestOption) (l *Log, err error)
 //
 // See: https://auth0.com/docs/api/management/v2#!/Logs/get_logs
 func (m *LogManager) List(opts ...RequestOption) (l []*Log, err error) {
-	err = m.Request("GET", m.URI("logs"), &l, opts...)
+	err = m.Request("GET", m.URI("logs"), &l, withOperation("Log.List", opts)...)
 	return
 }
 
+// Iterate returns an iterator over all log entries, fetching further pages as
+// the iteration advances.
+//
+// Pages are requested using checkpoint pagination, searching from the id of
+// the last log entry retrieved. The page size can be configured using Take.
+func (m *LogManager) Iterate(opts ...RequestOption) *LogIterator {
+	return &LogIterator{newCheckpointIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	}, func(_ List, items []interface{}) string {
+		if len(items) == 0 {
+			return ""
+		}
+		return items[len(items)-1].(*Log).GetLogID()
+	})}
+}
+
 // Search is an alias for List
 func (m *LogManager) Search(opts ...RequestOption) ([]*Log, error) {
 	return m.List(opts...)
@@ -28,6 +28,9 @@ type LogStream struct {
 
 	// Sink for validation.
 	Sink interface{} `json:"-"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (ls *LogStream) MarshalJSON() ([]byte, error) {
@@ -48,7 +51,11 @@ func (ls *LogStream) MarshalJSON() ([]byte, error) {
 		w.RawSink = b
 	}
 
-	return json.Marshal(w)
+	b, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(b, ls.Extra)
 }
 
 func (ls *LogStream) UnmarshalJSON(b []byte) error {
@@ -95,7 +102,8 @@ func (ls *LogStream) UnmarshalJSON(b []byte) error {
 		ls.Sink = v
 	}
 
-	return nil
+	ls.Extra, err = unmarshalExtra(b, w)
+	return err
 }
 
 type LogStreamSinkAmazonEventBridge struct {
@@ -105,6 +113,8 @@ type LogStreamSinkAmazonEventBridge struct {
 	Region *string `json:"awsRegion,omitempty"`
 	// AWS Partner Event Source
 	PartnerEventSource *string `json:"awsPartnerEventSource,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type LogStreamSinkAzureEventGrid struct {
@@ -116,6 +126,8 @@ type LogStreamSinkAzureEventGrid struct {
 	Region *string `json:"azureRegion,omitempty"`
 	// Azure Partner Topic
 	PartnerTopic *string `json:"azurePartnerTopic,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type LogStreamSinkHTTP struct {
@@ -129,6 +141,8 @@ type LogStreamSinkHTTP struct {
 	Authorization *string `json:"httpAuthorization,omitempty"`
 	// Custom HTTP headers
 	CustomHeaders []interface{} `json:"httpCustomHeaders,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type LogStreamSinkDatadog struct {
@@ -136,6 +150,8 @@ type LogStreamSinkDatadog struct {
 	Region *string `json:"datadogRegion,omitempty"`
 	// Datadog Api Key
 	APIKey *string `json:"datadogApiKey,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type LogStreamSinkSplunk struct {
@@ -147,11 +163,15 @@ type LogStreamSinkSplunk struct {
 	Port *string `json:"splunkPort,omitempty"`
 	// Splunk Secure
 	Secure *bool `json:"splunkSecure,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type LogStreamSinkSumo struct {
 	// Sumo Source Address
 	SourceAddress *string `json:"sumoSourceAddress,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type LogStreamManager struct {
@@ -166,14 +186,14 @@ func newLogStreamManager(m *Management) *LogStreamManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/log-streams
 func (m *LogStreamManager) Create(l *LogStream, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("log-streams"), l, opts...)
+	return m.Request("POST", m.URI("log-streams"), l, withOperation("LogStream.Create", opts)...)
 }
 
 // Read a log stream.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Log_Streams/get_log_streams_by_id
 func (m *LogStreamManager) Read(id string, opts ...RequestOption) (l *LogStream, err error) {
-	err = m.Request("GET", m.URI("log-streams", id), &l, opts...)
+	err = m.Request("GET", m.URI("log-streams", id), &l, withOperation("LogStream.Read", opts)...)
 	return
 }
 
@@ -181,7 +201,7 @@ func (m *LogStreamManager) Read(id string, opts ...RequestOption) (l *LogStream,
 //
 // See: https://auth0.com/docs/api/management/v2#!/log-streams/get_log_streams
 func (m *LogStreamManager) List(opts ...RequestOption) (ls []*LogStream, err error) {
-	err = m.Request("GET", m.URI("log-streams"), &ls, opts...)
+	err = m.Request("GET", m.URI("log-streams"), &ls, withOperation("LogStream.List", opts)...)
 	return
 }
 
@@ -194,12 +214,12 @@ func (m *LogStreamManager) List(opts ...RequestOption) (ls []*LogStream, err err
 //
 // See: https://auth0.com/docs/api/management/v2#!/log-streams
 func (m *LogStreamManager) Update(id string, l *LogStream, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("log-streams", id), l, opts...)
+	return m.Request("PATCH", m.URI("log-streams", id), l, withOperation("LogStream.Update", opts)...)
 }
 
 // Delete a log stream.
 //
 // See: https://auth0.com/docs/api/management/v2#!/log-streams
 func (m *LogStreamManager) Delete(id string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("log-streams", id), nil, opts...)
+	return m.Request("DELETE", m.URI("log-streams", id), nil, withOperation("LogStream.Delete", opts)...)
 }
@@ -2,7 +2,6 @@ package management
 
 import (
 	"testing"
-	"time"
 
 	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/internal/testing/expect"
@@ -11,7 +10,7 @@ import (
 func TestLogStream(t *testing.T) {
 
 	l := &LogStream{
-		Name: auth0.Stringf("Test-LogStream-%d", time.Now().Unix()),
+		Name: auth0.Stringf("Test-LogStream-%d", testTime().Unix()),
 		Type: auth0.String(LogStreamTypeDatadog),
 		Sink: &LogStreamSinkDatadog{
 			APIKey: auth0.String("12334567876543"),
@@ -110,7 +109,7 @@ func TestLogStreamSink(t *testing.T) {
 	t.Run("AmazonEventBridge", func(t *testing.T) {
 
 		l := &LogStream{
-			Name: auth0.Stringf("Test-LogStream-%d", time.Now().Unix()),
+			Name: auth0.Stringf("Test-LogStream-%d", testTime().Unix()),
 			Type: auth0.String(LogStreamTypeAmazonEventBridge),
 			Sink: &LogStreamSinkAmazonEventBridge{
 				AccountID: auth0.String("999999999999"),
@@ -144,7 +143,7 @@ func TestLogStreamSink(t *testing.T) {
 		t.Skip("this test requires an active subscription")
 
 		l := &LogStream{
-			Name: auth0.Stringf("Test-LogStream-%d", time.Now().Unix()),
+			Name: auth0.Stringf("Test-LogStream-%d", testTime().Unix()),
 			Type: auth0.String(LogStreamTypeAzureEventGrid),
 			Sink: &LogStreamSinkAzureEventGrid{
 				SubscriptionID: auth0.String("b69a6835-57c7-4d53-b0d5-1c6ae580b6d5"),
@@ -175,7 +174,7 @@ func TestLogStreamSink(t *testing.T) {
 
 	t.Run("HTTP", func(t *testing.T) {
 		l := &LogStream{
-			Name: auth0.Stringf("Test-LogStream-%d", time.Now().Unix()),
+			Name: auth0.Stringf("Test-LogStream-%d", testTime().Unix()),
 			Type: auth0.String(LogStreamTypeHTTP),
 			Sink: &LogStreamSinkHTTP{
 				Endpoint:      auth0.String("https://example.com/logs"),
@@ -209,7 +208,7 @@ func TestLogStreamSink(t *testing.T) {
 
 	t.Run("Datadog", func(t *testing.T) {
 		l := &LogStream{
-			Name: auth0.Stringf("Test-LogStream-%d", time.Now().Unix()),
+			Name: auth0.Stringf("Test-LogStream-%d", testTime().Unix()),
 			Type: auth0.String(LogStreamTypeDatadog),
 			Sink: &LogStreamSinkDatadog{
 				APIKey: auth0.String("121233123455"),
@@ -239,7 +238,7 @@ func TestLogStreamSink(t *testing.T) {
 
 	t.Run("Splunk", func(t *testing.T) {
 		l := &LogStream{
-			Name: auth0.Stringf("Test-LogStream-%d", time.Now().Unix()),
+			Name: auth0.Stringf("Test-LogStream-%d", testTime().Unix()),
 			Type: auth0.String(LogStreamTypeSplunk),
 			Sink: &LogStreamSinkSplunk{
 				Domain: auth0.String("demo.splunk.com"),
@@ -273,7 +272,7 @@ func TestLogStreamSink(t *testing.T) {
 
 	t.Run("Sumo", func(t *testing.T) {
 		l := &LogStream{
-			Name: auth0.Stringf("Test-LogStream-%d", time.Now().Unix()),
+			Name: auth0.Stringf("Test-LogStream-%d", testTime().Unix()),
 			Type: auth0.String(LogStreamTypeSumo),
 			Sink: &LogStreamSinkSumo{
 				SourceAddress: auth0.String("https://example.com"),
@@ -78,6 +78,21 @@ func (a *Action) GetUpdatedAt() time.Time {
 	return *a.UpdatedAt
 }
 
+// Clone returns a deep copy of Action.
+func (a *Action) Clone() *Action {
+	return clone(a).(*Action)
+}
+
+// Equal reports whether Action holds the same values as other.
+func (a *Action) Equal(other *Action) bool {
+	return len(a.Diff(other)) == 0
+}
+
+// Diff returns the properties of Action which differ from other.
+func (a *Action) Diff(other *Action) []Change {
+	return diff(a, other)
+}
+
 // String returns a string representation of Action.
 func (a *Action) String() string {
 	return Stringify(a)
@@ -139,6 +154,21 @@ func (a *ActionBinding) GetUpdatedAt() time.Time {
 	return *a.UpdatedAt
 }
 
+// Clone returns a deep copy of ActionBinding.
+func (a *ActionBinding) Clone() *ActionBinding {
+	return clone(a).(*ActionBinding)
+}
+
+// Equal reports whether ActionBinding holds the same values as other.
+func (a *ActionBinding) Equal(other *ActionBinding) bool {
+	return len(a.Diff(other)) == 0
+}
+
+// Diff returns the properties of ActionBinding which differ from other.
+func (a *ActionBinding) Diff(other *ActionBinding) []Change {
+	return diff(a, other)
+}
+
 // String returns a string representation of ActionBinding.
 func (a *ActionBinding) String() string {
 	return Stringify(a)
@@ -165,6 +195,21 @@ func (a *ActionBindingReference) GetValue() string {
 	return *a.Value
 }
 
+// Clone returns a deep copy of ActionBindingReference.
+func (a *ActionBindingReference) Clone() *ActionBindingReference {
+	return clone(a).(*ActionBindingReference)
+}
+
+// Equal reports whether ActionBindingReference holds the same values as other.
+func (a *ActionBindingReference) Equal(other *ActionBindingReference) bool {
+	return len(a.Diff(other)) == 0
+}
+
+// Diff returns the properties of ActionBindingReference which differ from other.
+func (a *ActionBindingReference) Diff(other *ActionBindingReference) []Change {
+	return diff(a, other)
+}
+
 // String returns a string representation of ActionBindingReference.
 func (a *ActionBindingReference) String() string {
 	return Stringify(a)
@@ -194,6 +239,21 @@ func (a *ActionDependency) GetVersion() string {
 	return *a.Version
 }
 
+// Clone returns a deep copy of ActionDependency.
+func (a *ActionDependency) Clone() *ActionDependency {
+	return clone(a).(*ActionDependency)
+}
+
+// Equal reports whether ActionDependency holds the same values as other.
+func (a *ActionDependency) Equal(other *ActionDependency) bool {
+	return len(a.Diff(other)) == 0
+}
+
+// Diff returns the properties of ActionDependency which differ from other.
+func (a *ActionDependency) Diff(other *ActionDependency) []Change {
+	return diff(a, other)
+}
+
 // String returns a string representation of ActionDependency.
 func (a *ActionDependency) String() string {
 	return Stringify(a)
@@ -302,6 +362,21 @@ func (a *ActionSecret) GetValue() string {
 	return *a.Value
 }
 
+// Clone returns a deep copy of ActionSecret.
+func (a *ActionSecret) Clone() *ActionSecret {
+	return clone(a).(*ActionSecret)
+}
+
+// Equal reports whether ActionSecret holds the same values as other.
+func (a *ActionSecret) Equal(other *ActionSecret) bool {
+	return len(a.Diff(other)) == 0
+}
+
+// Diff returns the properties of ActionSecret which differ from other.
+func (a *ActionSecret) Diff(other *ActionSecret) []Change {
+	return diff(a, other)
+}
+
 // String returns a string representation of ActionSecret.
 func (a *ActionSecret) String() string {
 	return Stringify(a)
@@ -331,6 +406,21 @@ func (a *ActionTrigger) GetVersion() string {
 	return *a.Version
 }
 
+// Clone returns a deep copy of ActionTrigger.
+func (a *ActionTrigger) Clone() *ActionTrigger {
+	return clone(a).(*ActionTrigger)
+}
+
+// Equal reports whether ActionTrigger holds the same values as other.
+func (a *ActionTrigger) Equal(other *ActionTrigger) bool {
+	return len(a.Diff(other)) == 0
+}
+
+// Diff returns the properties of ActionTrigger which differ from other.
+func (a *ActionTrigger) Diff(other *ActionTrigger) []Change {
+	return diff(a, other)
+}
+
 // String returns a string representation of ActionTrigger.
 func (a *ActionTrigger) String() string {
 	return Stringify(a)
@@ -436,6 +526,16 @@ func (a *ActionVersionList) String() string {
 	return Stringify(a)
 }
 
+// String returns a string representation of APIError.
+func (a *APIError) String() string {
+	return Stringify(a)
+}
+
+// String returns a string representation of AuditEvent.
+func (a *AuditEvent) String() string {
+	return Stringify(a)
+}
+
 // String returns a string representation of BlacklistToken.
 func (b *BlacklistToken) String() string {
 	return Stringify(b)
@@ -473,6 +573,21 @@ func (b *Branding) GetLogoURL() string {
 	return *b.LogoURL
 }
 
+// Clone returns a deep copy of Branding.
+func (b *Branding) Clone() *Branding {
+	return clone(b).(*Branding)
+}
+
+// Equal reports whether Branding holds the same values as other.
+func (b *Branding) Equal(other *Branding) bool {
+	return len(b.Diff(other)) == 0
+}
+
+// Diff returns the properties of Branding which differ from other.
+func (b *Branding) Diff(other *Branding) []Change {
+	return diff(b, other)
+}
+
 // String returns a string representation of Branding.
 func (b *Branding) String() string {
 	return Stringify(b)
@@ -502,6 +617,21 @@ func (b *BrandingColors) GetPrimary() string {
 	return *b.Primary
 }
 
+// Clone returns a deep copy of BrandingColors.
+func (b *BrandingColors) Clone() *BrandingColors {
+	return clone(b).(*BrandingColors)
+}
+
+// Equal reports whether BrandingColors holds the same values as other.
+func (b *BrandingColors) Equal(other *BrandingColors) bool {
+	return len(b.Diff(other)) == 0
+}
+
+// Diff returns the properties of BrandingColors which differ from other.
+func (b *BrandingColors) Diff(other *BrandingColors) []Change {
+	return diff(b, other)
+}
+
 // String returns a string representation of BrandingColors.
 func (b *BrandingColors) String() string {
 	return Stringify(b)
@@ -515,6 +645,21 @@ func (b *BrandingFont) GetURL() string {
 	return *b.URL
 }
 
+// Clone returns a deep copy of BrandingFont.
+func (b *BrandingFont) Clone() *BrandingFont {
+	return clone(b).(*BrandingFont)
+}
+
+// Equal reports whether BrandingFont holds the same values as other.
+func (b *BrandingFont) Equal(other *BrandingFont) bool {
+	return len(b.Diff(other)) == 0
+}
+
+// Diff returns the properties of BrandingFont which differ from other.
+func (b *BrandingFont) Diff(other *BrandingFont) []Change {
+	return diff(b, other)
+}
+
 // String returns a string representation of BrandingFont.
 func (b *BrandingFont) String() string {
 	return Stringify(b)
@@ -552,6 +697,21 @@ func (b *BrandingPageBackgroundGradient) GetType() string {
 	return *b.Type
 }
 
+// Clone returns a deep copy of BrandingPageBackgroundGradient.
+func (b *BrandingPageBackgroundGradient) Clone() *BrandingPageBackgroundGradient {
+	return clone(b).(*BrandingPageBackgroundGradient)
+}
+
+// Equal reports whether BrandingPageBackgroundGradient holds the same values as other.
+func (b *BrandingPageBackgroundGradient) Equal(other *BrandingPageBackgroundGradient) bool {
+	return len(b.Diff(other)) == 0
+}
+
+// Diff returns the properties of BrandingPageBackgroundGradient which differ from other.
+func (b *BrandingPageBackgroundGradient) Diff(other *BrandingPageBackgroundGradient) []Change {
+	return diff(b, other)
+}
+
 // String returns a string representation of BrandingPageBackgroundGradient.
 func (b *BrandingPageBackgroundGradient) String() string {
 	return Stringify(b)
@@ -565,6 +725,21 @@ func (b *BrandingUniversalLogin) GetBody() string {
 	return *b.Body
 }
 
+// Clone returns a deep copy of BrandingUniversalLogin.
+func (b *BrandingUniversalLogin) Clone() *BrandingUniversalLogin {
+	return clone(b).(*BrandingUniversalLogin)
+}
+
+// Equal reports whether BrandingUniversalLogin holds the same values as other.
+func (b *BrandingUniversalLogin) Equal(other *BrandingUniversalLogin) bool {
+	return len(b.Diff(other)) == 0
+}
+
+// Diff returns the properties of BrandingUniversalLogin which differ from other.
+func (b *BrandingUniversalLogin) Diff(other *BrandingUniversalLogin) []Change {
+	return diff(b, other)
+}
+
 // String returns a string representation of BrandingUniversalLogin.
 func (b *BrandingUniversalLogin) String() string {
 	return Stringify(b)
@@ -762,6 +937,21 @@ func (c *Client) GetTokenEndpointAuthMethod() string {
 	return *c.TokenEndpointAuthMethod
 }
 
+// Clone returns a deep copy of Client.
+func (c *Client) Clone() *Client {
+	return clone(c).(*Client)
+}
+
+// Equal reports whether Client holds the same values as other.
+func (c *Client) Equal(other *Client) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of Client which differ from other.
+func (c *Client) Diff(other *Client) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of Client.
 func (c *Client) String() string {
 	return Stringify(c)
@@ -791,6 +981,21 @@ func (c *ClientGrant) GetID() string {
 	return *c.ID
 }
 
+// Clone returns a deep copy of ClientGrant.
+func (c *ClientGrant) Clone() *ClientGrant {
+	return clone(c).(*ClientGrant)
+}
+
+// Equal reports whether ClientGrant holds the same values as other.
+func (c *ClientGrant) Equal(other *ClientGrant) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ClientGrant which differ from other.
+func (c *ClientGrant) Diff(other *ClientGrant) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ClientGrant.
 func (c *ClientGrant) String() string {
 	return Stringify(c)
@@ -825,6 +1030,21 @@ func (c *ClientJWTConfiguration) GetSecretEncoded() bool {
 	return *c.SecretEncoded
 }
 
+// Clone returns a deep copy of ClientJWTConfiguration.
+func (c *ClientJWTConfiguration) Clone() *ClientJWTConfiguration {
+	return clone(c).(*ClientJWTConfiguration)
+}
+
+// Equal reports whether ClientJWTConfiguration holds the same values as other.
+func (c *ClientJWTConfiguration) Equal(other *ClientJWTConfiguration) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ClientJWTConfiguration which differ from other.
+func (c *ClientJWTConfiguration) Diff(other *ClientJWTConfiguration) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ClientJWTConfiguration.
 func (c *ClientJWTConfiguration) String() string {
 	return Stringify(c)
@@ -835,6 +1055,21 @@ func (c *ClientList) String() string {
 	return Stringify(c)
 }
 
+// Clone returns a deep copy of ClientNativeSocialLogin.
+func (c *ClientNativeSocialLogin) Clone() *ClientNativeSocialLogin {
+	return clone(c).(*ClientNativeSocialLogin)
+}
+
+// Equal reports whether ClientNativeSocialLogin holds the same values as other.
+func (c *ClientNativeSocialLogin) Equal(other *ClientNativeSocialLogin) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ClientNativeSocialLogin which differ from other.
+func (c *ClientNativeSocialLogin) Diff(other *ClientNativeSocialLogin) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ClientNativeSocialLogin.
 func (c *ClientNativeSocialLogin) String() string {
 	return Stringify(c)
@@ -904,6 +1139,21 @@ func (c *ClientRefreshToken) GetType() string {
 	return *c.Type
 }
 
+// Clone returns a deep copy of ClientRefreshToken.
+func (c *ClientRefreshToken) Clone() *ClientRefreshToken {
+	return clone(c).(*ClientRefreshToken)
+}
+
+// Equal reports whether ClientRefreshToken holds the same values as other.
+func (c *ClientRefreshToken) Equal(other *ClientRefreshToken) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ClientRefreshToken which differ from other.
+func (c *ClientRefreshToken) Diff(other *ClientRefreshToken) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ClientRefreshToken.
 func (c *ClientRefreshToken) String() string {
 	return Stringify(c)
@@ -957,6 +1207,21 @@ func (c *Connection) GetStrategy() string {
 	return *c.Strategy
 }
 
+// Clone returns a deep copy of Connection.
+func (c *Connection) Clone() *Connection {
+	return clone(c).(*Connection)
+}
+
+// Equal reports whether Connection holds the same values as other.
+func (c *Connection) Equal(other *Connection) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of Connection which differ from other.
+func (c *Connection) Diff(other *Connection) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of Connection.
 func (c *Connection) String() string {
 	return Stringify(c)
@@ -1002,6 +1267,21 @@ func (c *ConnectionGatewayAuthentication) GetSubject() string {
 	return *c.Subject
 }
 
+// Clone returns a deep copy of ConnectionGatewayAuthentication.
+func (c *ConnectionGatewayAuthentication) Clone() *ConnectionGatewayAuthentication {
+	return clone(c).(*ConnectionGatewayAuthentication)
+}
+
+// Equal reports whether ConnectionGatewayAuthentication holds the same values as other.
+func (c *ConnectionGatewayAuthentication) Equal(other *ConnectionGatewayAuthentication) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionGatewayAuthentication which differ from other.
+func (c *ConnectionGatewayAuthentication) Diff(other *ConnectionGatewayAuthentication) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionGatewayAuthentication.
 func (c *ConnectionGatewayAuthentication) String() string {
 	return Stringify(c)
@@ -1084,6 +1364,21 @@ func (c *ConnectionOptions) GetStrategyVersion() int {
 	return *c.StrategyVersion
 }
 
+// Clone returns a deep copy of ConnectionOptions.
+func (c *ConnectionOptions) Clone() *ConnectionOptions {
+	return clone(c).(*ConnectionOptions)
+}
+
+// Equal reports whether ConnectionOptions holds the same values as other.
+func (c *ConnectionOptions) Equal(other *ConnectionOptions) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptions which differ from other.
+func (c *ConnectionOptions) Diff(other *ConnectionOptions) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptions.
 func (c *ConnectionOptions) String() string {
 	return Stringify(c)
@@ -1153,6 +1448,21 @@ func (c *ConnectionOptionsAD) GetTenantDomain() string {
 	return *c.TenantDomain
 }
 
+// Clone returns a deep copy of ConnectionOptionsAD.
+func (c *ConnectionOptionsAD) Clone() *ConnectionOptionsAD {
+	return clone(c).(*ConnectionOptionsAD)
+}
+
+// Equal reports whether ConnectionOptionsAD holds the same values as other.
+func (c *ConnectionOptionsAD) Equal(other *ConnectionOptionsAD) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsAD which differ from other.
+func (c *ConnectionOptionsAD) Diff(other *ConnectionOptionsAD) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsAD.
 func (c *ConnectionOptionsAD) String() string {
 	return Stringify(c)
@@ -1206,6 +1516,21 @@ func (c *ConnectionOptionsADFS) GetTenantDomain() string {
 	return *c.TenantDomain
 }
 
+// Clone returns a deep copy of ConnectionOptionsADFS.
+func (c *ConnectionOptionsADFS) Clone() *ConnectionOptionsADFS {
+	return clone(c).(*ConnectionOptionsADFS)
+}
+
+// Equal reports whether ConnectionOptionsADFS holds the same values as other.
+func (c *ConnectionOptionsADFS) Equal(other *ConnectionOptionsADFS) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsADFS which differ from other.
+func (c *ConnectionOptionsADFS) Diff(other *ConnectionOptionsADFS) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsADFS.
 func (c *ConnectionOptionsADFS) String() string {
 	return Stringify(c)
@@ -1283,6 +1608,21 @@ func (c *ConnectionOptionsApple) GetTeamID() string {
 	return *c.TeamID
 }
 
+// Clone returns a deep copy of ConnectionOptionsApple.
+func (c *ConnectionOptionsApple) Clone() *ConnectionOptionsApple {
+	return clone(c).(*ConnectionOptionsApple)
+}
+
+// Equal reports whether ConnectionOptionsApple holds the same values as other.
+func (c *ConnectionOptionsApple) Equal(other *ConnectionOptionsApple) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsApple which differ from other.
+func (c *ConnectionOptionsApple) Diff(other *ConnectionOptionsApple) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsApple.
 func (c *ConnectionOptionsApple) String() string {
 	return Stringify(c)
@@ -1480,6 +1820,21 @@ func (c *ConnectionOptionsAzureAD) GetWAADProtocol() string {
 	return *c.WAADProtocol
 }
 
+// Clone returns a deep copy of ConnectionOptionsAzureAD.
+func (c *ConnectionOptionsAzureAD) Clone() *ConnectionOptionsAzureAD {
+	return clone(c).(*ConnectionOptionsAzureAD)
+}
+
+// Equal reports whether ConnectionOptionsAzureAD holds the same values as other.
+func (c *ConnectionOptionsAzureAD) Equal(other *ConnectionOptionsAzureAD) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsAzureAD which differ from other.
+func (c *ConnectionOptionsAzureAD) Diff(other *ConnectionOptionsAzureAD) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsAzureAD.
 func (c *ConnectionOptionsAzureAD) String() string {
 	return Stringify(c)
@@ -1541,6 +1896,21 @@ func (c *ConnectionOptionsEmail) GetSetUserAttributes() string {
 	return *c.SetUserAttributes
 }
 
+// Clone returns a deep copy of ConnectionOptionsEmail.
+func (c *ConnectionOptionsEmail) Clone() *ConnectionOptionsEmail {
+	return clone(c).(*ConnectionOptionsEmail)
+}
+
+// Equal reports whether ConnectionOptionsEmail holds the same values as other.
+func (c *ConnectionOptionsEmail) Equal(other *ConnectionOptionsEmail) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsEmail which differ from other.
+func (c *ConnectionOptionsEmail) Diff(other *ConnectionOptionsEmail) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsEmail.
 func (c *ConnectionOptionsEmail) String() string {
 	return Stringify(c)
@@ -1578,6 +1948,21 @@ func (c *ConnectionOptionsEmailSettings) GetSyntax() string {
 	return *c.Syntax
 }
 
+// Clone returns a deep copy of ConnectionOptionsEmailSettings.
+func (c *ConnectionOptionsEmailSettings) Clone() *ConnectionOptionsEmailSettings {
+	return clone(c).(*ConnectionOptionsEmailSettings)
+}
+
+// Equal reports whether ConnectionOptionsEmailSettings holds the same values as other.
+func (c *ConnectionOptionsEmailSettings) Equal(other *ConnectionOptionsEmailSettings) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsEmailSettings which differ from other.
+func (c *ConnectionOptionsEmailSettings) Diff(other *ConnectionOptionsEmailSettings) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsEmailSettings.
 func (c *ConnectionOptionsEmailSettings) String() string {
 	return Stringify(c)
@@ -1951,6 +2336,21 @@ func (c *ConnectionOptionsFacebook) GetUserVideos() bool {
 	return *c.UserVideos
 }
 
+// Clone returns a deep copy of ConnectionOptionsFacebook.
+func (c *ConnectionOptionsFacebook) Clone() *ConnectionOptionsFacebook {
+	return clone(c).(*ConnectionOptionsFacebook)
+}
+
+// Equal reports whether ConnectionOptionsFacebook holds the same values as other.
+func (c *ConnectionOptionsFacebook) Equal(other *ConnectionOptionsFacebook) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsFacebook which differ from other.
+func (c *ConnectionOptionsFacebook) Diff(other *ConnectionOptionsFacebook) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsFacebook.
 func (c *ConnectionOptionsFacebook) String() string {
 	return Stringify(c)
@@ -2148,6 +2548,21 @@ func (c *ConnectionOptionsGitHub) GetWriteRepoHook() bool {
 	return *c.WriteRepoHook
 }
 
+// Clone returns a deep copy of ConnectionOptionsGitHub.
+func (c *ConnectionOptionsGitHub) Clone() *ConnectionOptionsGitHub {
+	return clone(c).(*ConnectionOptionsGitHub)
+}
+
+// Equal reports whether ConnectionOptionsGitHub holds the same values as other.
+func (c *ConnectionOptionsGitHub) Equal(other *ConnectionOptionsGitHub) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsGitHub which differ from other.
+func (c *ConnectionOptionsGitHub) Diff(other *ConnectionOptionsGitHub) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsGitHub.
 func (c *ConnectionOptionsGitHub) String() string {
 	return Stringify(c)
@@ -2265,6 +2680,21 @@ func (c *ConnectionOptionsGoogleApps) GetTenantDomain() string {
 	return *c.TenantDomain
 }
 
+// Clone returns a deep copy of ConnectionOptionsGoogleApps.
+func (c *ConnectionOptionsGoogleApps) Clone() *ConnectionOptionsGoogleApps {
+	return clone(c).(*ConnectionOptionsGoogleApps)
+}
+
+// Equal reports whether ConnectionOptionsGoogleApps holds the same values as other.
+func (c *ConnectionOptionsGoogleApps) Equal(other *ConnectionOptionsGoogleApps) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsGoogleApps which differ from other.
+func (c *ConnectionOptionsGoogleApps) Diff(other *ConnectionOptionsGoogleApps) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsGoogleApps.
 func (c *ConnectionOptionsGoogleApps) String() string {
 	return Stringify(c)
@@ -2542,6 +2972,21 @@ func (c *ConnectionOptionsGoogleOAuth2) GetYoutube() bool {
 	return *c.Youtube
 }
 
+// Clone returns a deep copy of ConnectionOptionsGoogleOAuth2.
+func (c *ConnectionOptionsGoogleOAuth2) Clone() *ConnectionOptionsGoogleOAuth2 {
+	return clone(c).(*ConnectionOptionsGoogleOAuth2)
+}
+
+// Equal reports whether ConnectionOptionsGoogleOAuth2 holds the same values as other.
+func (c *ConnectionOptionsGoogleOAuth2) Equal(other *ConnectionOptionsGoogleOAuth2) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsGoogleOAuth2 which differ from other.
+func (c *ConnectionOptionsGoogleOAuth2) Diff(other *ConnectionOptionsGoogleOAuth2) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsGoogleOAuth2.
 func (c *ConnectionOptionsGoogleOAuth2) String() string {
 	return Stringify(c)
@@ -2611,6 +3056,21 @@ func (c *ConnectionOptionsLinkedin) GetStrategyVersion() int {
 	return *c.StrategyVersion
 }
 
+// Clone returns a deep copy of ConnectionOptionsLinkedin.
+func (c *ConnectionOptionsLinkedin) Clone() *ConnectionOptionsLinkedin {
+	return clone(c).(*ConnectionOptionsLinkedin)
+}
+
+// Equal reports whether ConnectionOptionsLinkedin holds the same values as other.
+func (c *ConnectionOptionsLinkedin) Equal(other *ConnectionOptionsLinkedin) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsLinkedin which differ from other.
+func (c *ConnectionOptionsLinkedin) Diff(other *ConnectionOptionsLinkedin) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsLinkedin.
 func (c *ConnectionOptionsLinkedin) String() string {
 	return Stringify(c)
@@ -2672,6 +3132,21 @@ func (c *ConnectionOptionsOAuth2) GetTokenURL() string {
 	return *c.TokenURL
 }
 
+// Clone returns a deep copy of ConnectionOptionsOAuth2.
+func (c *ConnectionOptionsOAuth2) Clone() *ConnectionOptionsOAuth2 {
+	return clone(c).(*ConnectionOptionsOAuth2)
+}
+
+// Equal reports whether ConnectionOptionsOAuth2 holds the same values as other.
+func (c *ConnectionOptionsOAuth2) Equal(other *ConnectionOptionsOAuth2) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsOAuth2 which differ from other.
+func (c *ConnectionOptionsOAuth2) Diff(other *ConnectionOptionsOAuth2) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsOAuth2.
 func (c *ConnectionOptionsOAuth2) String() string {
 	return Stringify(c)
@@ -2789,6 +3264,21 @@ func (c *ConnectionOptionsOIDC) GetUserInfoEndpoint() string {
 	return *c.UserInfoEndpoint
 }
 
+// Clone returns a deep copy of ConnectionOptionsOIDC.
+func (c *ConnectionOptionsOIDC) Clone() *ConnectionOptionsOIDC {
+	return clone(c).(*ConnectionOptionsOIDC)
+}
+
+// Equal reports whether ConnectionOptionsOIDC holds the same values as other.
+func (c *ConnectionOptionsOIDC) Equal(other *ConnectionOptionsOIDC) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsOIDC which differ from other.
+func (c *ConnectionOptionsOIDC) Diff(other *ConnectionOptionsOIDC) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsOIDC.
 func (c *ConnectionOptionsOIDC) String() string {
 	return Stringify(c)
@@ -2810,6 +3300,21 @@ func (c *ConnectionOptionsOTP) GetTimeStep() int {
 	return *c.TimeStep
 }
 
+// Clone returns a deep copy of ConnectionOptionsOTP.
+func (c *ConnectionOptionsOTP) Clone() *ConnectionOptionsOTP {
+	return clone(c).(*ConnectionOptionsOTP)
+}
+
+// Equal reports whether ConnectionOptionsOTP holds the same values as other.
+func (c *ConnectionOptionsOTP) Equal(other *ConnectionOptionsOTP) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsOTP which differ from other.
+func (c *ConnectionOptionsOTP) Diff(other *ConnectionOptionsOTP) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsOTP.
 func (c *ConnectionOptionsOTP) String() string {
 	return Stringify(c)
@@ -2863,6 +3368,21 @@ func (c *ConnectionOptionsSalesforce) GetSetUserAttributes() string {
 	return *c.SetUserAttributes
 }
 
+// Clone returns a deep copy of ConnectionOptionsSalesforce.
+func (c *ConnectionOptionsSalesforce) Clone() *ConnectionOptionsSalesforce {
+	return clone(c).(*ConnectionOptionsSalesforce)
+}
+
+// Equal reports whether ConnectionOptionsSalesforce holds the same values as other.
+func (c *ConnectionOptionsSalesforce) Equal(other *ConnectionOptionsSalesforce) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsSalesforce which differ from other.
+func (c *ConnectionOptionsSalesforce) Diff(other *ConnectionOptionsSalesforce) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsSalesforce.
 func (c *ConnectionOptionsSalesforce) String() string {
 	return Stringify(c)
@@ -3036,6 +3556,21 @@ func (c *ConnectionOptionsSAML) GetUserIDAttribute() string {
 	return *c.UserIDAttribute
 }
 
+// Clone returns a deep copy of ConnectionOptionsSAML.
+func (c *ConnectionOptionsSAML) Clone() *ConnectionOptionsSAML {
+	return clone(c).(*ConnectionOptionsSAML)
+}
+
+// Equal reports whether ConnectionOptionsSAML holds the same values as other.
+func (c *ConnectionOptionsSAML) Equal(other *ConnectionOptionsSAML) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsSAML which differ from other.
+func (c *ConnectionOptionsSAML) Diff(other *ConnectionOptionsSAML) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsSAML.
 func (c *ConnectionOptionsSAML) String() string {
 	return Stringify(c)
@@ -3089,6 +3624,21 @@ func (c *ConnectionOptionsSAMLIdpInitiated) GetSetUserAttributes() string {
 	return *c.SetUserAttributes
 }
 
+// Clone returns a deep copy of ConnectionOptionsSAMLIdpInitiated.
+func (c *ConnectionOptionsSAMLIdpInitiated) Clone() *ConnectionOptionsSAMLIdpInitiated {
+	return clone(c).(*ConnectionOptionsSAMLIdpInitiated)
+}
+
+// Equal reports whether ConnectionOptionsSAMLIdpInitiated holds the same values as other.
+func (c *ConnectionOptionsSAMLIdpInitiated) Equal(other *ConnectionOptionsSAMLIdpInitiated) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsSAMLIdpInitiated which differ from other.
+func (c *ConnectionOptionsSAMLIdpInitiated) Diff(other *ConnectionOptionsSAMLIdpInitiated) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsSAMLIdpInitiated.
 func (c *ConnectionOptionsSAMLIdpInitiated) String() string {
 	return Stringify(c)
@@ -3110,6 +3660,21 @@ func (c *ConnectionOptionsSAMLSigningKey) GetKey() string {
 	return *c.Key
 }
 
+// Clone returns a deep copy of ConnectionOptionsSAMLSigningKey.
+func (c *ConnectionOptionsSAMLSigningKey) Clone() *ConnectionOptionsSAMLSigningKey {
+	return clone(c).(*ConnectionOptionsSAMLSigningKey)
+}
+
+// Equal reports whether ConnectionOptionsSAMLSigningKey holds the same values as other.
+func (c *ConnectionOptionsSAMLSigningKey) Equal(other *ConnectionOptionsSAMLSigningKey) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsSAMLSigningKey which differ from other.
+func (c *ConnectionOptionsSAMLSigningKey) Diff(other *ConnectionOptionsSAMLSigningKey) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsSAMLSigningKey.
 func (c *ConnectionOptionsSAMLSigningKey) String() string {
 	return Stringify(c)
@@ -3227,6 +3792,21 @@ func (c *ConnectionOptionsSMS) GetTwilioToken() string {
 	return *c.TwilioToken
 }
 
+// Clone returns a deep copy of ConnectionOptionsSMS.
+func (c *ConnectionOptionsSMS) Clone() *ConnectionOptionsSMS {
+	return clone(c).(*ConnectionOptionsSMS)
+}
+
+// Equal reports whether ConnectionOptionsSMS holds the same values as other.
+func (c *ConnectionOptionsSMS) Equal(other *ConnectionOptionsSMS) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsSMS which differ from other.
+func (c *ConnectionOptionsSMS) Diff(other *ConnectionOptionsSMS) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsSMS.
 func (c *ConnectionOptionsSMS) String() string {
 	return Stringify(c)
@@ -3448,6 +4028,21 @@ func (c *ConnectionOptionsWindowsLive) GetUserUpdate() bool {
 	return *c.UserUpdate
 }
 
+// Clone returns a deep copy of ConnectionOptionsWindowsLive.
+func (c *ConnectionOptionsWindowsLive) Clone() *ConnectionOptionsWindowsLive {
+	return clone(c).(*ConnectionOptionsWindowsLive)
+}
+
+// Equal reports whether ConnectionOptionsWindowsLive holds the same values as other.
+func (c *ConnectionOptionsWindowsLive) Equal(other *ConnectionOptionsWindowsLive) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of ConnectionOptionsWindowsLive which differ from other.
+func (c *ConnectionOptionsWindowsLive) Diff(other *ConnectionOptionsWindowsLive) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of ConnectionOptionsWindowsLive.
 func (c *ConnectionOptionsWindowsLive) String() string {
 	return Stringify(c)
@@ -3530,6 +4125,21 @@ func (c *CustomDomain) GetVerificationMethod() string {
 	return *c.VerificationMethod
 }
 
+// Clone returns a deep copy of CustomDomain.
+func (c *CustomDomain) Clone() *CustomDomain {
+	return clone(c).(*CustomDomain)
+}
+
+// Equal reports whether CustomDomain holds the same values as other.
+func (c *CustomDomain) Equal(other *CustomDomain) bool {
+	return len(c.Diff(other)) == 0
+}
+
+// Diff returns the properties of CustomDomain which differ from other.
+func (c *CustomDomain) Diff(other *CustomDomain) []Change {
+	return diff(c, other)
+}
+
 // String returns a string representation of CustomDomain.
 func (c *CustomDomain) String() string {
 	return Stringify(c)
@@ -3625,6 +4235,21 @@ func (e *Email) GetName() string {
 	return *e.Name
 }
 
+// Clone returns a deep copy of Email.
+func (e *Email) Clone() *Email {
+	return clone(e).(*Email)
+}
+
+// Equal reports whether Email holds the same values as other.
+func (e *Email) Equal(other *Email) bool {
+	return len(e.Diff(other)) == 0
+}
+
+// Diff returns the properties of Email which differ from other.
+func (e *Email) Diff(other *Email) []Change {
+	return diff(e, other)
+}
+
 // String returns a string representation of Email.
 func (e *Email) String() string {
 	return Stringify(e)
@@ -3710,6 +4335,21 @@ func (e *EmailCredentials) GetSMTPUser() string {
 	return *e.SMTPUser
 }
 
+// Clone returns a deep copy of EmailCredentials.
+func (e *EmailCredentials) Clone() *EmailCredentials {
+	return clone(e).(*EmailCredentials)
+}
+
+// Equal reports whether EmailCredentials holds the same values as other.
+func (e *EmailCredentials) Equal(other *EmailCredentials) bool {
+	return len(e.Diff(other)) == 0
+}
+
+// Diff returns the properties of EmailCredentials which differ from other.
+func (e *EmailCredentials) Diff(other *EmailCredentials) []Change {
+	return diff(e, other)
+}
+
 // String returns a string representation of EmailCredentials.
 func (e *EmailCredentials) String() string {
 	return Stringify(e)
@@ -3779,6 +4419,21 @@ func (e *EmailTemplate) GetURLLifetimeInSecoonds() int {
 	return *e.URLLifetimeInSecoonds
 }
 
+// Clone returns a deep copy of EmailTemplate.
+func (e *EmailTemplate) Clone() *EmailTemplate {
+	return clone(e).(*EmailTemplate)
+}
+
+// Equal reports whether EmailTemplate holds the same values as other.
+func (e *EmailTemplate) Equal(other *EmailTemplate) bool {
+	return len(e.Diff(other)) == 0
+}
+
+// Diff returns the properties of EmailTemplate which differ from other.
+func (e *EmailTemplate) Diff(other *EmailTemplate) []Change {
+	return diff(e, other)
+}
+
 // String returns a string representation of EmailTemplate.
 func (e *EmailTemplate) String() string {
 	return Stringify(e)
@@ -3892,6 +4547,11 @@ func (g *GrantList) String() string {
 	return Stringify(g)
 }
 
+// String returns a string representation of GuardError.
+func (g *GuardError) String() string {
+	return Stringify(g)
+}
+
 // GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
 func (h *Hook) GetEnabled() bool {
 	if h == nil || h.Enabled == nil {
@@ -3932,6 +4592,21 @@ func (h *Hook) GetTriggerID() string {
 	return *h.TriggerID
 }
 
+// Clone returns a deep copy of Hook.
+func (h *Hook) Clone() *Hook {
+	return clone(h).(*Hook)
+}
+
+// Equal reports whether Hook holds the same values as other.
+func (h *Hook) Equal(other *Hook) bool {
+	return len(h.Diff(other)) == 0
+}
+
+// Diff returns the properties of Hook which differ from other.
+func (h *Hook) Diff(other *Hook) []Change {
+	return diff(h, other)
+}
+
 // String returns a string representation of Hook.
 func (h *Hook) String() string {
 	return Stringify(h)
@@ -4030,6 +4705,14 @@ func (j *Job) GetStatus() string {
 	return *j.Status
 }
 
+// GetSummary returns the Summary field.
+func (j *Job) GetSummary() *JobSummary {
+	if j == nil {
+		return nil
+	}
+	return j.Summary
+}
+
 // GetTimeLeftSeconds returns the TimeLeftSeconds field if it's non-nil, zero value otherwise.
 func (j *Job) GetTimeLeftSeconds() int {
 	if j == nil || j.TimeLeftSeconds == nil {
@@ -4067,6 +4750,77 @@ func (j *Job) String() string {
 	return Stringify(j)
 }
 
+// String returns a string representation of JobError.
+func (j *JobError) String() string {
+	return Stringify(j)
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (j *JobErrorDetails) GetCode() string {
+	if j == nil || j.Code == nil {
+		return ""
+	}
+	return *j.Code
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (j *JobErrorDetails) GetMessage() string {
+	if j == nil || j.Message == nil {
+		return ""
+	}
+	return *j.Message
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (j *JobErrorDetails) GetPath() string {
+	if j == nil || j.Path == nil {
+		return ""
+	}
+	return *j.Path
+}
+
+// String returns a string representation of JobErrorDetails.
+func (j *JobErrorDetails) String() string {
+	return Stringify(j)
+}
+
+// GetFailed returns the Failed field if it's non-nil, zero value otherwise.
+func (j *JobSummary) GetFailed() int {
+	if j == nil || j.Failed == nil {
+		return 0
+	}
+	return *j.Failed
+}
+
+// GetInserted returns the Inserted field if it's non-nil, zero value otherwise.
+func (j *JobSummary) GetInserted() int {
+	if j == nil || j.Inserted == nil {
+		return 0
+	}
+	return *j.Inserted
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (j *JobSummary) GetTotal() int {
+	if j == nil || j.Total == nil {
+		return 0
+	}
+	return *j.Total
+}
+
+// GetUpdated returns the Updated field if it's non-nil, zero value otherwise.
+func (j *JobSummary) GetUpdated() int {
+	if j == nil || j.Updated == nil {
+		return 0
+	}
+	return *j.Updated
+}
+
+// String returns a string representation of JobSummary.
+func (j *JobSummary) String() string {
+	return Stringify(j)
+}
+
 // String returns a string representation of List.
 func (l *List) String() string {
 	return Stringify(l)
@@ -4181,6 +4935,21 @@ func (l *LogStream) GetType() string {
 	return *l.Type
 }
 
+// Clone returns a deep copy of LogStream.
+func (l *LogStream) Clone() *LogStream {
+	return clone(l).(*LogStream)
+}
+
+// Equal reports whether LogStream holds the same values as other.
+func (l *LogStream) Equal(other *LogStream) bool {
+	return len(l.Diff(other)) == 0
+}
+
+// Diff returns the properties of LogStream which differ from other.
+func (l *LogStream) Diff(other *LogStream) []Change {
+	return diff(l, other)
+}
+
 // String returns a string representation of LogStream.
 func (l *LogStream) String() string {
 	return Stringify(l)
@@ -4210,6 +4979,21 @@ func (l *LogStreamSinkAmazonEventBridge) GetRegion() string {
 	return *l.Region
 }
 
+// Clone returns a deep copy of LogStreamSinkAmazonEventBridge.
+func (l *LogStreamSinkAmazonEventBridge) Clone() *LogStreamSinkAmazonEventBridge {
+	return clone(l).(*LogStreamSinkAmazonEventBridge)
+}
+
+// Equal reports whether LogStreamSinkAmazonEventBridge holds the same values as other.
+func (l *LogStreamSinkAmazonEventBridge) Equal(other *LogStreamSinkAmazonEventBridge) bool {
+	return len(l.Diff(other)) == 0
+}
+
+// Diff returns the properties of LogStreamSinkAmazonEventBridge which differ from other.
+func (l *LogStreamSinkAmazonEventBridge) Diff(other *LogStreamSinkAmazonEventBridge) []Change {
+	return diff(l, other)
+}
+
 // String returns a string representation of LogStreamSinkAmazonEventBridge.
 func (l *LogStreamSinkAmazonEventBridge) String() string {
 	return Stringify(l)
@@ -4247,6 +5031,21 @@ func (l *LogStreamSinkAzureEventGrid) GetSubscriptionID() string {
 	return *l.SubscriptionID
 }
 
+// Clone returns a deep copy of LogStreamSinkAzureEventGrid.
+func (l *LogStreamSinkAzureEventGrid) Clone() *LogStreamSinkAzureEventGrid {
+	return clone(l).(*LogStreamSinkAzureEventGrid)
+}
+
+// Equal reports whether LogStreamSinkAzureEventGrid holds the same values as other.
+func (l *LogStreamSinkAzureEventGrid) Equal(other *LogStreamSinkAzureEventGrid) bool {
+	return len(l.Diff(other)) == 0
+}
+
+// Diff returns the properties of LogStreamSinkAzureEventGrid which differ from other.
+func (l *LogStreamSinkAzureEventGrid) Diff(other *LogStreamSinkAzureEventGrid) []Change {
+	return diff(l, other)
+}
+
 // String returns a string representation of LogStreamSinkAzureEventGrid.
 func (l *LogStreamSinkAzureEventGrid) String() string {
 	return Stringify(l)
@@ -4268,6 +5067,21 @@ func (l *LogStreamSinkDatadog) GetRegion() string {
 	return *l.Region
 }
 
+// Clone returns a deep copy of LogStreamSinkDatadog.
+func (l *LogStreamSinkDatadog) Clone() *LogStreamSinkDatadog {
+	return clone(l).(*LogStreamSinkDatadog)
+}
+
+// Equal reports whether LogStreamSinkDatadog holds the same values as other.
+func (l *LogStreamSinkDatadog) Equal(other *LogStreamSinkDatadog) bool {
+	return len(l.Diff(other)) == 0
+}
+
+// Diff returns the properties of LogStreamSinkDatadog which differ from other.
+func (l *LogStreamSinkDatadog) Diff(other *LogStreamSinkDatadog) []Change {
+	return diff(l, other)
+}
+
 // String returns a string representation of LogStreamSinkDatadog.
 func (l *LogStreamSinkDatadog) String() string {
 	return Stringify(l)
@@ -4305,6 +5119,21 @@ func (l *LogStreamSinkHTTP) GetEndpoint() string {
 	return *l.Endpoint
 }
 
+// Clone returns a deep copy of LogStreamSinkHTTP.
+func (l *LogStreamSinkHTTP) Clone() *LogStreamSinkHTTP {
+	return clone(l).(*LogStreamSinkHTTP)
+}
+
+// Equal reports whether LogStreamSinkHTTP holds the same values as other.
+func (l *LogStreamSinkHTTP) Equal(other *LogStreamSinkHTTP) bool {
+	return len(l.Diff(other)) == 0
+}
+
+// Diff returns the properties of LogStreamSinkHTTP which differ from other.
+func (l *LogStreamSinkHTTP) Diff(other *LogStreamSinkHTTP) []Change {
+	return diff(l, other)
+}
+
 // String returns a string representation of LogStreamSinkHTTP.
 func (l *LogStreamSinkHTTP) String() string {
 	return Stringify(l)
@@ -4342,6 +5171,21 @@ func (l *LogStreamSinkSplunk) GetToken() string {
 	return *l.Token
 }
 
+// Clone returns a deep copy of LogStreamSinkSplunk.
+func (l *LogStreamSinkSplunk) Clone() *LogStreamSinkSplunk {
+	return clone(l).(*LogStreamSinkSplunk)
+}
+
+// Equal reports whether LogStreamSinkSplunk holds the same values as other.
+func (l *LogStreamSinkSplunk) Equal(other *LogStreamSinkSplunk) bool {
+	return len(l.Diff(other)) == 0
+}
+
+// Diff returns the properties of LogStreamSinkSplunk which differ from other.
+func (l *LogStreamSinkSplunk) Diff(other *LogStreamSinkSplunk) []Change {
+	return diff(l, other)
+}
+
 // String returns a string representation of LogStreamSinkSplunk.
 func (l *LogStreamSinkSplunk) String() string {
 	return Stringify(l)
@@ -4355,6 +5199,21 @@ func (l *LogStreamSinkSumo) GetSourceAddress() string {
 	return *l.SourceAddress
 }
 
+// Clone returns a deep copy of LogStreamSinkSumo.
+func (l *LogStreamSinkSumo) Clone() *LogStreamSinkSumo {
+	return clone(l).(*LogStreamSinkSumo)
+}
+
+// Equal reports whether LogStreamSinkSumo holds the same values as other.
+func (l *LogStreamSinkSumo) Equal(other *LogStreamSinkSumo) bool {
+	return len(l.Diff(other)) == 0
+}
+
+// Diff returns the properties of LogStreamSinkSumo which differ from other.
+func (l *LogStreamSinkSumo) Diff(other *LogStreamSinkSumo) []Change {
+	return diff(l, other)
+}
+
 // String returns a string representation of LogStreamSinkSumo.
 func (l *LogStreamSinkSumo) String() string {
 	return Stringify(l)
@@ -4462,6 +5321,21 @@ func (m *MultiFactorProviderAmazonSNS) GetSecretAccessKeyID() string {
 	return *m.SecretAccessKeyID
 }
 
+// Clone returns a deep copy of MultiFactorProviderAmazonSNS.
+func (m *MultiFactorProviderAmazonSNS) Clone() *MultiFactorProviderAmazonSNS {
+	return clone(m).(*MultiFactorProviderAmazonSNS)
+}
+
+// Equal reports whether MultiFactorProviderAmazonSNS holds the same values as other.
+func (m *MultiFactorProviderAmazonSNS) Equal(other *MultiFactorProviderAmazonSNS) bool {
+	return len(m.Diff(other)) == 0
+}
+
+// Diff returns the properties of MultiFactorProviderAmazonSNS which differ from other.
+func (m *MultiFactorProviderAmazonSNS) Diff(other *MultiFactorProviderAmazonSNS) []Change {
+	return diff(m, other)
+}
+
 // String returns a string representation of MultiFactorProviderAmazonSNS.
 func (m *MultiFactorProviderAmazonSNS) String() string {
 	return Stringify(m)
@@ -4499,6 +5373,21 @@ func (m *MultiFactorProviderTwilio) GetSID() string {
 	return *m.SID
 }
 
+// Clone returns a deep copy of MultiFactorProviderTwilio.
+func (m *MultiFactorProviderTwilio) Clone() *MultiFactorProviderTwilio {
+	return clone(m).(*MultiFactorProviderTwilio)
+}
+
+// Equal reports whether MultiFactorProviderTwilio holds the same values as other.
+func (m *MultiFactorProviderTwilio) Equal(other *MultiFactorProviderTwilio) bool {
+	return len(m.Diff(other)) == 0
+}
+
+// Diff returns the properties of MultiFactorProviderTwilio which differ from other.
+func (m *MultiFactorProviderTwilio) Diff(other *MultiFactorProviderTwilio) []Change {
+	return diff(m, other)
+}
+
 // String returns a string representation of MultiFactorProviderTwilio.
 func (m *MultiFactorProviderTwilio) String() string {
 	return Stringify(m)
@@ -4530,6 +5419,21 @@ func (m *MultiFactorSMSTemplate) GetVerificationMessage() string {
 	return *m.VerificationMessage
 }
 
+// Clone returns a deep copy of MultiFactorSMSTemplate.
+func (m *MultiFactorSMSTemplate) Clone() *MultiFactorSMSTemplate {
+	return clone(m).(*MultiFactorSMSTemplate)
+}
+
+// Equal reports whether MultiFactorSMSTemplate holds the same values as other.
+func (m *MultiFactorSMSTemplate) Equal(other *MultiFactorSMSTemplate) bool {
+	return len(m.Diff(other)) == 0
+}
+
+// Diff returns the properties of MultiFactorSMSTemplate which differ from other.
+func (m *MultiFactorSMSTemplate) Diff(other *MultiFactorSMSTemplate) []Change {
+	return diff(m, other)
+}
+
 // String returns a string representation of MultiFactorSMSTemplate.
 func (m *MultiFactorSMSTemplate) String() string {
 	return Stringify(m)
@@ -4545,6 +5449,11 @@ func (m *MultiFactorWebAuthnRoaming) String() string {
 	return Stringify(m)
 }
 
+// String returns a string representation of Mutation.
+func (m *Mutation) String() string {
+	return Stringify(m)
+}
+
 // GetBranding returns the Branding field.
 func (o *Organization) GetBranding() *OrganizationBranding {
 	if o == nil {
@@ -4577,6 +5486,21 @@ func (o *Organization) GetName() string {
 	return *o.Name
 }
 
+// Clone returns a deep copy of Organization.
+func (o *Organization) Clone() *Organization {
+	return clone(o).(*Organization)
+}
+
+// Equal reports whether Organization holds the same values as other.
+func (o *Organization) Equal(other *Organization) bool {
+	return len(o.Diff(other)) == 0
+}
+
+// Diff returns the properties of Organization which differ from other.
+func (o *Organization) Diff(other *Organization) []Change {
+	return diff(o, other)
+}
+
 // String returns a string representation of Organization.
 func (o *Organization) String() string {
 	return Stringify(o)
@@ -4590,6 +5514,21 @@ func (o *OrganizationBranding) GetLogoURL() string {
 	return *o.LogoURL
 }
 
+// Clone returns a deep copy of OrganizationBranding.
+func (o *OrganizationBranding) Clone() *OrganizationBranding {
+	return clone(o).(*OrganizationBranding)
+}
+
+// Equal reports whether OrganizationBranding holds the same values as other.
+func (o *OrganizationBranding) Equal(other *OrganizationBranding) bool {
+	return len(o.Diff(other)) == 0
+}
+
+// Diff returns the properties of OrganizationBranding which differ from other.
+func (o *OrganizationBranding) Diff(other *OrganizationBranding) []Change {
+	return diff(o, other)
+}
+
 // String returns a string representation of OrganizationBranding.
 func (o *OrganizationBranding) String() string {
 	return Stringify(o)
@@ -4918,6 +5857,16 @@ func (p *PhoneMessageTypes) String() string {
 	return Stringify(p)
 }
 
+// String returns a string representation of PlannedMutation.
+func (p *PlannedMutation) String() string {
+	return Stringify(p)
+}
+
+// String returns a string representation of PoolResult.
+func (p *PoolResult) String() string {
+	return Stringify(p)
+}
+
 // GetIdentifierFirst returns the IdentifierFirst field if it's non-nil, zero value otherwise.
 func (p *Prompt) GetIdentifierFirst() bool {
 	if p == nil || p.IdentifierFirst == nil {
@@ -4926,11 +5875,36 @@ func (p *Prompt) GetIdentifierFirst() bool {
 	return *p.IdentifierFirst
 }
 
+// Clone returns a deep copy of Prompt.
+func (p *Prompt) Clone() *Prompt {
+	return clone(p).(*Prompt)
+}
+
+// Equal reports whether Prompt holds the same values as other.
+func (p *Prompt) Equal(other *Prompt) bool {
+	return len(p.Diff(other)) == 0
+}
+
+// Diff returns the properties of Prompt which differ from other.
+func (p *Prompt) Diff(other *Prompt) []Change {
+	return diff(p, other)
+}
+
 // String returns a string representation of Prompt.
 func (p *Prompt) String() string {
 	return Stringify(p)
 }
 
+// String returns a string representation of RateLimit.
+func (r *RateLimit) String() string {
+	return Stringify(r)
+}
+
+// String returns a string representation of RequestInfo.
+func (r *RequestInfo) String() string {
+	return Stringify(r)
+}
+
 // GetAllowOfflineAccess returns the AllowOfflineAccess field if it's non-nil, zero value otherwise.
 func (r *ResourceServer) GetAllowOfflineAccess() bool {
 	if r == nil || r.AllowOfflineAccess == nil {
@@ -5027,6 +6001,21 @@ func (r *ResourceServer) GetVerificationLocation() string {
 	return *r.VerificationLocation
 }
 
+// Clone returns a deep copy of ResourceServer.
+func (r *ResourceServer) Clone() *ResourceServer {
+	return clone(r).(*ResourceServer)
+}
+
+// Equal reports whether ResourceServer holds the same values as other.
+func (r *ResourceServer) Equal(other *ResourceServer) bool {
+	return len(r.Diff(other)) == 0
+}
+
+// Diff returns the properties of ResourceServer which differ from other.
+func (r *ResourceServer) Diff(other *ResourceServer) []Change {
+	return diff(r, other)
+}
+
 // String returns a string representation of ResourceServer.
 func (r *ResourceServer) String() string {
 	return Stringify(r)
@@ -5053,11 +6042,31 @@ func (r *ResourceServerScope) GetValue() string {
 	return *r.Value
 }
 
+// Clone returns a deep copy of ResourceServerScope.
+func (r *ResourceServerScope) Clone() *ResourceServerScope {
+	return clone(r).(*ResourceServerScope)
+}
+
+// Equal reports whether ResourceServerScope holds the same values as other.
+func (r *ResourceServerScope) Equal(other *ResourceServerScope) bool {
+	return len(r.Diff(other)) == 0
+}
+
+// Diff returns the properties of ResourceServerScope which differ from other.
+func (r *ResourceServerScope) Diff(other *ResourceServerScope) []Change {
+	return diff(r, other)
+}
+
 // String returns a string representation of ResourceServerScope.
 func (r *ResourceServerScope) String() string {
 	return Stringify(r)
 }
 
+// String returns a string representation of RetryPolicy.
+func (r *RetryPolicy) String() string {
+	return Stringify(r)
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (r *Role) GetDescription() string {
 	if r == nil || r.Description == nil {
@@ -5082,6 +6091,21 @@ func (r *Role) GetName() string {
 	return *r.Name
 }
 
+// Clone returns a deep copy of Role.
+func (r *Role) Clone() *Role {
+	return clone(r).(*Role)
+}
+
+// Equal reports whether Role holds the same values as other.
+func (r *Role) Equal(other *Role) bool {
+	return len(r.Diff(other)) == 0
+}
+
+// Diff returns the properties of Role which differ from other.
+func (r *Role) Diff(other *Role) []Change {
+	return diff(r, other)
+}
+
 // String returns a string representation of Role.
 func (r *Role) String() string {
 	return Stringify(r)
@@ -5132,6 +6156,21 @@ func (r *Rule) GetScript() string {
 	return *r.Script
 }
 
+// Clone returns a deep copy of Rule.
+func (r *Rule) Clone() *Rule {
+	return clone(r).(*Rule)
+}
+
+// Equal reports whether Rule holds the same values as other.
+func (r *Rule) Equal(other *Rule) bool {
+	return len(r.Diff(other)) == 0
+}
+
+// Diff returns the properties of Rule which differ from other.
+func (r *Rule) Diff(other *Rule) []Change {
+	return diff(r, other)
+}
+
 // String returns a string representation of Rule.
 func (r *Rule) String() string {
 	return Stringify(r)
@@ -5392,6 +6431,21 @@ func (t *Tenant) GetUniversalLogin() *TenantUniversalLogin {
 	return t.UniversalLogin
 }
 
+// Clone returns a deep copy of Tenant.
+func (t *Tenant) Clone() *Tenant {
+	return clone(t).(*Tenant)
+}
+
+// Equal reports whether Tenant holds the same values as other.
+func (t *Tenant) Equal(other *Tenant) bool {
+	return len(t.Diff(other)) == 0
+}
+
+// Diff returns the properties of Tenant which differ from other.
+func (t *Tenant) Diff(other *Tenant) []Change {
+	return diff(t, other)
+}
+
 // String returns a string representation of Tenant.
 func (t *Tenant) String() string {
 	return Stringify(t)
@@ -5413,6 +6467,21 @@ func (t *TenantChangePassword) GetHTML() string {
 	return *t.HTML
 }
 
+// Clone returns a deep copy of TenantChangePassword.
+func (t *TenantChangePassword) Clone() *TenantChangePassword {
+	return clone(t).(*TenantChangePassword)
+}
+
+// Equal reports whether TenantChangePassword holds the same values as other.
+func (t *TenantChangePassword) Equal(other *TenantChangePassword) bool {
+	return len(t.Diff(other)) == 0
+}
+
+// Diff returns the properties of TenantChangePassword which differ from other.
+func (t *TenantChangePassword) Diff(other *TenantChangePassword) []Change {
+	return diff(t, other)
+}
+
 // String returns a string representation of TenantChangePassword.
 func (t *TenantChangePassword) String() string {
 	return Stringify(t)
@@ -5434,6 +6503,21 @@ func (t *TenantDeviceFlow) GetMask() string {
 	return *t.Mask
 }
 
+// Clone returns a deep copy of TenantDeviceFlow.
+func (t *TenantDeviceFlow) Clone() *TenantDeviceFlow {
+	return clone(t).(*TenantDeviceFlow)
+}
+
+// Equal reports whether TenantDeviceFlow holds the same values as other.
+func (t *TenantDeviceFlow) Equal(other *TenantDeviceFlow) bool {
+	return len(t.Diff(other)) == 0
+}
+
+// Diff returns the properties of TenantDeviceFlow which differ from other.
+func (t *TenantDeviceFlow) Diff(other *TenantDeviceFlow) []Change {
+	return diff(t, other)
+}
+
 // String returns a string representation of TenantDeviceFlow.
 func (t *TenantDeviceFlow) String() string {
 	return Stringify(t)
@@ -5463,6 +6547,21 @@ func (t *TenantErrorPage) GetURL() string {
 	return *t.URL
 }
 
+// Clone returns a deep copy of TenantErrorPage.
+func (t *TenantErrorPage) Clone() *TenantErrorPage {
+	return clone(t).(*TenantErrorPage)
+}
+
+// Equal reports whether TenantErrorPage holds the same values as other.
+func (t *TenantErrorPage) Equal(other *TenantErrorPage) bool {
+	return len(t.Diff(other)) == 0
+}
+
+// Diff returns the properties of TenantErrorPage which differ from other.
+func (t *TenantErrorPage) Diff(other *TenantErrorPage) []Change {
+	return diff(t, other)
+}
+
 // String returns a string representation of TenantErrorPage.
 func (t *TenantErrorPage) String() string {
 	return Stringify(t)
@@ -5580,6 +6679,21 @@ func (t *TenantFlags) GetUseScopeDescriptionsForConsent() bool {
 	return *t.UseScopeDescriptionsForConsent
 }
 
+// Clone returns a deep copy of TenantFlags.
+func (t *TenantFlags) Clone() *TenantFlags {
+	return clone(t).(*TenantFlags)
+}
+
+// Equal reports whether TenantFlags holds the same values as other.
+func (t *TenantFlags) Equal(other *TenantFlags) bool {
+	return len(t.Diff(other)) == 0
+}
+
+// Diff returns the properties of TenantFlags which differ from other.
+func (t *TenantFlags) Diff(other *TenantFlags) []Change {
+	return diff(t, other)
+}
+
 // String returns a string representation of TenantFlags.
 func (t *TenantFlags) String() string {
 	return Stringify(t)
@@ -5601,6 +6715,21 @@ func (t *TenantGuardianMFAPage) GetHTML() string {
 	return *t.HTML
 }
 
+// Clone returns a deep copy of TenantGuardianMFAPage.
+func (t *TenantGuardianMFAPage) Clone() *TenantGuardianMFAPage {
+	return clone(t).(*TenantGuardianMFAPage)
+}
+
+// Equal reports whether TenantGuardianMFAPage holds the same values as other.
+func (t *TenantGuardianMFAPage) Equal(other *TenantGuardianMFAPage) bool {
+	return len(t.Diff(other)) == 0
+}
+
+// Diff returns the properties of TenantGuardianMFAPage which differ from other.
+func (t *TenantGuardianMFAPage) Diff(other *TenantGuardianMFAPage) []Change {
+	return diff(t, other)
+}
+
 // String returns a string representation of TenantGuardianMFAPage.
 func (t *TenantGuardianMFAPage) String() string {
 	return Stringify(t)
@@ -5614,6 +6743,21 @@ func (t *TenantUniversalLogin) GetColors() *TenantUniversalLoginColors {
 	return t.Colors
 }
 
+// Clone returns a deep copy of TenantUniversalLogin.
+func (t *TenantUniversalLogin) Clone() *TenantUniversalLogin {
+	return clone(t).(*TenantUniversalLogin)
+}
+
+// Equal reports whether TenantUniversalLogin holds the same values as other.
+func (t *TenantUniversalLogin) Equal(other *TenantUniversalLogin) bool {
+	return len(t.Diff(other)) == 0
+}
+
+// Diff returns the properties of TenantUniversalLogin which differ from other.
+func (t *TenantUniversalLogin) Diff(other *TenantUniversalLogin) []Change {
+	return diff(t, other)
+}
+
 // String returns a string representation of TenantUniversalLogin.
 func (t *TenantUniversalLogin) String() string {
 	return Stringify(t)
@@ -5643,6 +6787,21 @@ func (t *TenantUniversalLoginColors) GetPrimary() string {
 	return *t.Primary
 }
 
+// Clone returns a deep copy of TenantUniversalLoginColors.
+func (t *TenantUniversalLoginColors) Clone() *TenantUniversalLoginColors {
+	return clone(t).(*TenantUniversalLoginColors)
+}
+
+// Equal reports whether TenantUniversalLoginColors holds the same values as other.
+func (t *TenantUniversalLoginColors) Equal(other *TenantUniversalLoginColors) bool {
+	return len(t.Diff(other)) == 0
+}
+
+// Diff returns the properties of TenantUniversalLoginColors which differ from other.
+func (t *TenantUniversalLoginColors) Diff(other *TenantUniversalLoginColors) []Change {
+	return diff(t, other)
+}
+
 // String returns a string representation of TenantUniversalLoginColors.
 func (t *TenantUniversalLoginColors) String() string {
 	return Stringify(t)
@@ -5909,6 +7068,21 @@ func (u *User) GetVerifyEmail() bool {
 	return *u.VerifyEmail
 }
 
+// Clone returns a deep copy of User.
+func (u *User) Clone() *User {
+	return clone(u).(*User)
+}
+
+// Equal reports whether User holds the same values as other.
+func (u *User) Equal(other *User) bool {
+	return len(u.Diff(other)) == 0
+}
+
+// Diff returns the properties of User which differ from other.
+func (u *User) Diff(other *User) []Change {
+	return diff(u, other)
+}
+
 // String returns a string representation of User.
 func (u *User) String() string {
 	return Stringify(u)
@@ -6110,6 +7284,16 @@ func (u *UserIdentityLink) String() string {
 	return Stringify(u)
 }
 
+// String returns a string representation of UserImportFailure.
+func (u *UserImportFailure) String() string {
+	return Stringify(u)
+}
+
+// String returns a string representation of UserImportReport.
+func (u *UserImportReport) String() string {
+	return Stringify(u)
+}
+
 // String returns a string representation of UserList.
 func (u *UserList) String() string {
 	return Stringify(u)
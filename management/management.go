@@ -1,18 +1,25 @@
 package management
 
 //go:generate go run gen-methods.go
+//go:generate go run gen-context.go
+//go:generate go run gen-extra.go
+//go:generate go run gen-paths.go
 
 import (
 	"bytes"
 	"context"
+	"crypto"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/oauth2"
 	"gopkg.in/auth0.v5/internal/client"
@@ -21,15 +28,52 @@ import (
 type ManagementOption func(*Management)
 
 // WithDebug configures the management client to dump http requests and
-// responses to stdout.
+// responses to the standard logger. Sensitive headers and fields are redacted.
 func WithDebug(d bool) ManagementOption {
 	return func(m *Management) {
 		m.debug = d
 	}
 }
 
-// WitContext configures the management client to use the provided context
-// instead of the provided one.
+// Logger writes the debug output of the management client. It is implemented
+// by *log.Logger, and can be adapted to structured loggers.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithDebugLogger configures the management client to dump http requests and
+// responses to the provided logger, and enables debug.
+func WithDebugLogger(l Logger) ManagementOption {
+	return func(m *Management) {
+		m.debug = true
+		m.debugger.Printf = l.Printf
+	}
+}
+
+// WithDebugWriter configures the management client to dump http requests and
+// responses to the provided writer, and enables debug.
+func WithDebugWriter(w io.Writer) ManagementOption {
+	return WithDebugLogger(log.New(w, "", log.LstdFlags))
+}
+
+// WithDebugRedactedFields configures the management client to redact the
+// values of the provided JSON and form fields from its debug output, in
+// addition to the fields known to be sensitive such as passwords, client
+// secrets and tokens. The "Authorization" header is always redacted.
+func WithDebugRedactedFields(fields ...string) ManagementOption {
+	return func(m *Management) {
+		m.debugger.Fields = append(m.debugger.Fields, fields...)
+	}
+}
+
+// WithContext configures the management client to use the provided context
+// for its token requests, for example to provide the HTTP client used to
+// request tokens through oauth2.HTTPClient.
+//
+// Requests are canceled along with their own context instead, as provided by
+// the Context variants of the manager methods, such as UserManager.ReadContext,
+// or the Context request option. This includes waiting for a token, for the
+// rate limit to reset or before retrying a request.
 func WithContext(ctx context.Context) ManagementOption {
 	return func(m *Management) {
 		m.ctx = ctx
@@ -48,7 +92,24 @@ func WithUserAgent(userAgent string) ManagementOption {
 // credentials authentication flow.
 func WithClientCredentials(clientID, clientSecret string) ManagementOption {
 	return func(m *Management) {
-		m.tokenSource = client.ClientCredentials(m.ctx, m.url.String(), clientID, clientSecret)
+		m.setCredentials(func() oauth2.TokenSource {
+			return client.ClientCredentials(m.ctx, m.url.String(), clientID, clientSecret, m.tokenCache)
+		})
+	}
+}
+
+// WithPrivateKeyJWT configures management to authenticate using the client
+// credentials authentication flow, where the token request is authenticated
+// with a client assertion signed by the provided RSA or EC private key instead
+// of a client secret.
+//
+// The key ID identifies the key among the credentials registered for the
+// client.
+func WithPrivateKeyJWT(clientID, keyID string, key crypto.Signer) ManagementOption {
+	return func(m *Management) {
+		m.setCredentials(func() oauth2.TokenSource {
+			return client.PrivateKeyJWT(m.ctx, m.url.String(), clientID, keyID, key, m.tokenCache)
+		})
 	}
 }
 
@@ -56,7 +117,7 @@ func WithClientCredentials(clientID, clientSecret string) ManagementOption {
 // authentication token.
 func WithStaticToken(token string) ManagementOption {
 	return func(m *Management) {
-		m.tokenSource = client.StaticToken(token)
+		m.setTokenSource(client.StaticToken(token))
 	}
 }
 
@@ -67,11 +128,54 @@ func WithStaticToken(token string) ManagementOption {
 // production.
 func WithInsecure() ManagementOption {
 	return func(m *Management) {
-		m.tokenSource = client.StaticToken("insecure")
+		m.setTokenSource(client.StaticToken("insecure"))
 		m.url.Scheme = "http"
 	}
 }
 
+// TokenCache stores management tokens, so that they can be reused by several
+// Management instances instead of requesting a new token for each of them.
+//
+// Implementations must be safe for concurrent use. Get returns a nil token
+// without an error when no token is cached for the key.
+type TokenCache = client.TokenCache
+
+// AtomicTokenCache is a TokenCache which stays locked while a missing or
+// expiring token is requested, so that concurrent users of the cache wait for
+// that token instead of each requesting one. The caches returned by
+// NewMemoryTokenCache and NewFileTokenCache implement it.
+type AtomicTokenCache = client.AtomicTokenCache
+
+// TokenCacheKey identifies a cached token by the tenant domain, the client ID
+// and the audience it was issued for.
+type TokenCacheKey = client.TokenCacheKey
+
+// NewMemoryTokenCache returns a TokenCache keeping tokens in memory. It can be
+// shared by several Management instances within a process.
+func NewMemoryTokenCache() TokenCache {
+	return client.NewMemoryTokenCache()
+}
+
+// NewFileTokenCache returns a TokenCache keeping tokens in a file at path. It
+// can be shared by several processes, such as successive runs of a command.
+//
+// The file is locked while in use, including while a token missing from the
+// cache is requested, and encrypted using a key derived from the provided
+// secret. The secret should be a random value of at least 32 bytes, as the
+// derivation does not make up for a guessable one.
+func NewFileTokenCache(path string, secret []byte) TokenCache {
+	return client.NewFileTokenCache(path, secret)
+}
+
+// WithTokenCache configures management to share the tokens obtained using
+// WithClientCredentials or WithPrivateKeyJWT through the provided cache.
+// Cached tokens are refreshed a few minutes before they expire.
+func WithTokenCache(cache TokenCache) ManagementOption {
+	return func(m *Management) {
+		m.tokenCache = cache
+	}
+}
+
 // WithClient configures management to use the provided client.
 func WithClient(client *http.Client) ManagementOption {
 	return func(m *Management) {
@@ -79,6 +183,65 @@ func WithClient(client *http.Client) ManagementOption {
 	}
 }
 
+// WithDownloadClient configures the client used to download the files produced
+// by jobs, such as user exports, which are not sent to the Auth0 Management
+// API. Defaults to the client configured using WithClient.
+func WithDownloadClient(client *http.Client) ManagementOption {
+	return func(m *Management) {
+		m.download = client
+	}
+}
+
+// RetryPolicy configures how the management client retries requests failing
+// with a transient error. Fields left to their zero value use a default.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including
+	// the first attempt. Defaults to 3.
+	MaxAttempts int
+
+	// BaseDelay is the base delay of the exponential backoff between attempts.
+	// Defaults to 250ms.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff delay between two attempts. Defaults to 10s.
+	// Rate limited requests wait until the limit resets instead, and responses
+	// with a Retry-After header for as long as it indicates.
+	MaxDelay time.Duration
+
+	// MaxWait is the maximum total time spent waiting between the attempts of
+	// a request. Defaults to no limit.
+	MaxWait time.Duration
+
+	// Statuses are the response status codes that are retried. Defaults to
+	// 429, 500, 502, 503 and 504.
+	Statuses []int
+
+	// Methods are the request methods that are retried on network errors or
+	// any of the retryable status codes except 429, which is always retried
+	// as the request was not processed. Defaults to every idempotent method,
+	// so that POST requests are not retried.
+	Methods []string
+}
+
+// WithRetries configures the management client to retry requests failing with
+// a transient error according to the provided policy. Without it, only rate
+// limited requests are retried.
+func WithRetries(p RetryPolicy) ManagementOption {
+	return func(m *Management) {
+		m.retryPolicy = &p
+	}
+}
+
+// WithRateLimiter configures the management client to pace its requests
+// according to the rate limit budget reported by the Auth0 Management API,
+// instead of only slowing down once requests are rejected with a 429 status
+// code. The budget is shared by every request sent through the client.
+func WithRateLimiter() ManagementOption {
+	return func(m *Management) {
+		m.rateLimiter = client.NewRateLimiter()
+	}
+}
+
 // Management is an Auth0 management client used to interact with the Auth0
 // Management API v2.
 //
@@ -169,9 +332,20 @@ type Management struct {
 	basePath    string
 	userAgent   string
 	debug       bool
+	debugger    client.Debugger
+	retryPolicy *RetryPolicy
+	rateLimiter *client.RateLimiter
+	hooks       []RequestHook
+	readOnly    bool
+	guardRules  []GuardRule
+	plan        *Plan
+	auditSink   AuditSink
 	ctx         context.Context
 	tokenSource oauth2.TokenSource
+	tokenCache  TokenCache
+	credentials func() oauth2.TokenSource
 	http        *http.Client
+	download    *http.Client
 }
 
 // New creates a new Auth0 Management client by authenticating using the
@@ -203,10 +377,31 @@ func New(domain string, options ...ManagementOption) (*Management, error) {
 		option(m)
 	}
 
-	m.http = client.Wrap(m.http, m.tokenSource,
-		client.WithDebug(m.debug),
-		client.WithUserAgent(m.userAgent),
-		client.WithRateLimit())
+	if m.credentials != nil {
+		m.tokenSource = m.credentials()
+	}
+
+	var clientOptions []client.Option
+	if m.debug {
+		clientOptions = append(clientOptions, client.WithDebugger(m.debugger))
+	}
+	clientOptions = append(clientOptions, client.WithUserAgent(m.userAgent))
+	if m.rateLimiter != nil {
+		clientOptions = append(clientOptions, client.WithRateLimiter(m.rateLimiter))
+	}
+	if len(m.hooks) > 0 {
+		clientOptions = append(clientOptions, client.WithAttempts())
+	}
+	if m.retryPolicy != nil {
+		clientOptions = append(clientOptions, client.WithRetries(client.RetryPolicy(*m.retryPolicy)))
+	} else {
+		clientOptions = append(clientOptions, client.WithRateLimit())
+	}
+
+	if m.download == nil {
+		m.download = m.http
+	}
+	m.http = client.Wrap(m.http, m.tokenSource, clientOptions...)
 
 	m.Client = newClientManager(m)
 	m.ClientGrant = newClientGrantManager(m)
@@ -239,6 +434,40 @@ func New(domain string, options ...ManagementOption) (*Management, error) {
 	return m, nil
 }
 
+// setCredentials configures the token source to be created once every option
+// has been applied, so that it does not depend on the order of options.
+func (m *Management) setCredentials(fn func() oauth2.TokenSource) {
+	m.tokenSource = nil
+	m.credentials = fn
+}
+
+func (m *Management) setTokenSource(ts oauth2.TokenSource) {
+	m.tokenSource = ts
+	m.credentials = nil
+}
+
+// RateLimit is the rate limit budget reported by the Auth0 Management API.
+type RateLimit struct {
+	// The maximum number of requests allowed in the current window.
+	Limit int
+
+	// The number of requests remaining in the current window.
+	Remaining int
+
+	// The time at which the budget is fully restored.
+	Reset time.Time
+}
+
+// RateLimit returns the rate limit budget last reported by the Auth0
+// Management API. The budget is only tracked when the client is configured
+// using WithRateLimiter, and is zero until a response has been received.
+func (m *Management) RateLimit() RateLimit {
+	if m.rateLimiter == nil {
+		return RateLimit{}
+	}
+	return RateLimit(m.rateLimiter.Budget())
+}
+
 // URI returns the absolute URL of the Management API with any path segments
 // appended to the end.
 func (m *Management) URI(path ...string) string {
@@ -276,7 +505,27 @@ func (m *Management) NewRequest(method, uri string, payload interface{}, options
 
 // Do sends an HTTP request and returns an HTTP response, handling any context
 // cancellations or timeouts.
+//
+// Requests modifying resources are rejected if the client is read-only or by
+// the guard rules, and recorded without being sent in dry-run mode.
 func (m *Management) Do(req *http.Request) (*http.Response, error) {
+	if err := m.guard(req); err != nil {
+		return nil, err
+	}
+	if m.plan != nil && isMutation(req.Method) {
+		return m.dryRun(req)
+	}
+	send := m.do
+	if len(m.hooks) > 0 {
+		send = m.doWithHooks
+	}
+	if m.auditSink != nil && isMutation(req.Method) {
+		return m.doWithAudit(req, send)
+	}
+	return send(req)
+}
+
+func (m *Management) do(req *http.Request) (*http.Response, error) {
 
 	ctx := req.Context()
 
@@ -302,13 +551,13 @@ func (m *Management) Request(method, uri string, v interface{}, options ...Reque
 		return err
 	}
 
-	res, err := m.Do(req)
+	res, err := m.Do(withPayload(req, v))
 	if err != nil {
 		return fmt.Errorf("request failed: %w", err)
 	}
 
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
-		return newError(res.Body)
+		return newError(res)
 	}
 
 	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusAccepted {
@@ -331,45 +580,131 @@ type Error interface {
 	error
 }
 
-type managementError struct {
-	StatusCode int    `json:"statusCode"`
-	Err        string `json:"error"`
-	Message    string `json:"message"`
+// Sentinel errors which can be used with errors.Is to determine the kind of
+// an error returned by the Auth0 Management API.
+//
+// For example:
+//   _, err := m.User.Read(id)
+//   if errors.Is(err, management.ErrNotFound) {
+//       // handle missing user
+//   }
+var (
+	ErrBadRequest        = errors.New("bad request")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrForbidden         = errors.New("forbidden")
+	ErrInsufficientScope = errors.New("insufficient scope")
+	ErrNotFound          = errors.New("not found")
+	ErrConflict          = errors.New("conflict")
+	ErrRateLimited       = errors.New("rate limited")
+)
+
+// APIError is the error returned by the Auth0 Management API when a request
+// fails. It implements the Error interface and can be retrieved from an error
+// chain using errors.As.
+type APIError struct {
+	// The HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+
+	// The reason phrase of the status code, e.g. "Not Found".
+	Err string `json:"error"`
+
+	// A description of the error.
+	Message string `json:"message"`
+
+	// A machine readable code identifying the error, e.g. "inexistent_user".
+	// Not every error carries a code.
+	ErrorCode string `json:"errorCode,omitempty"`
+
+	// The method and URL of the request which failed.
+	Method string `json:"-"`
+	URL    string `json:"-"`
+
+	// The headers of the response, including any X-RateLimit-* headers.
+	Header http.Header `json:"-"`
+
+	// The raw body of the response.
+	Body []byte `json:"-"`
 }
 
-func newError(r io.Reader) error {
-	m := &managementError{}
-	err := json.NewDecoder(r).Decode(m)
+// newError reads the body of an unsuccessful response into an APIError. If the
+// body isn't in the format used by Auth0, the error message is the body
+// itself.
+func newError(res *http.Response) error {
+	defer res.Body.Close()
+
+	b, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return err
+		return fmt.Errorf("reading error response failed: %w", err)
 	}
-	return m
-}
-
-func (m *managementError) Error() string {
-	return fmt.Sprintf("%d %s: %s", m.StatusCode, m.Err, m.Message)
-}
 
-func (m *managementError) Status() int {
-	return m.StatusCode
+	e := &APIError{}
+	if err := json.Unmarshal(b, e); err != nil || e.StatusCode == 0 {
+		e = &APIError{Message: string(bytes.TrimSpace(b))}
+	}
+	e.StatusCode = res.StatusCode
+	if e.Err == "" {
+		e.Err = http.StatusText(res.StatusCode)
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.URL = res.Request.URL.String()
+	}
+	e.Header = res.Header
+	e.Body = b
+	return e
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Err, e.Message)
+}
+
+// Status returns the HTTP status code of the response.
+func (e *APIError) Status() int {
+	return e.StatusCode
+}
+
+// Is reports whether the error matches one of the sentinel errors, such as
+// ErrNotFound. It is used by errors.Is.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrInsufficientScope:
+		return e.StatusCode == http.StatusForbidden && e.ErrorCode == "insufficient_scope"
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
 }
 
 // List is an envelope which is typically used when calling List() or Search()
 // methods.
 //
 // It holds metadata such as the total result count, starting offset and limit.
+// Endpoints using checkpoint pagination instead return the checkpoint from
+// which to request the next page.
 //
 // Specific implementations embed this struct, therefore its direct use is not
 // useful. Rather it has been made public in order to aid documentation.
 type List struct {
-	Start  int `json:"start"`
-	Limit  int `json:"limit"`
-	Length int `json:"length"`
-	Total  int `json:"total"`
+	Start  int    `json:"start"`
+	Limit  int    `json:"limit"`
+	Length int    `json:"length"`
+	Total  int    `json:"total"`
+	Next   string `json:"next,omitempty"`
 }
 
+// HasNext returns true if there are more pages to be retrieved.
 func (l List) HasNext() bool {
-	return l.Total > l.Start+l.Limit
+	return l.Next != "" || l.Total > l.Start+l.Limit
 }
 
 // RequestOption configures a call (typically to retrieve a resource) to Auth0 with
@@ -407,6 +742,36 @@ func Context(ctx context.Context) RequestOption {
 	})
 }
 
+// withContext returns the options followed by Context(ctx), so that ctx takes
+// precedence over any context set by the options.
+func withContext(ctx context.Context, opts []RequestOption) []RequestOption {
+	return append(append(make([]RequestOption, 0, len(opts)+1), opts...), Context(ctx))
+}
+
+// Operation configures a request to be reported as sent by the named logical
+// operation, such as "User.Update", to request hooks, guard rules, audit sinks
+// and dry runs. Manager methods name the requests they send after themselves,
+// which takes precedence over this option.
+func Operation(name string) RequestOption {
+	return newRequestOption(func(r *http.Request) {
+		*r = *r.WithContext(context.WithValue(r.Context(), operationKey{}, name))
+	})
+}
+
+type operationKey struct{}
+
+// withOperation returns the options followed by Operation(name), applied after
+// any context set by the options so that the name is not lost.
+func withOperation(name string, opts []RequestOption) []RequestOption {
+	return append(append(make([]RequestOption, 0, len(opts)+1), opts...), Operation(name))
+}
+
+// operation returns the logical operation which sent the request, if any.
+func operation(req *http.Request) string {
+	name, _ := req.Context().Value(operationKey{}).(string)
+	return name
+}
+
 // WithFields configures a request to include the desired fields.
 //
 // Deprecated: use IncludeFields instead.
@@ -460,6 +825,26 @@ func PerPage(items int) RequestOption {
 	})
 }
 
+// From configures a request to start from the specified checkpoint, when using
+// checkpoint pagination.
+func From(checkpoint string) RequestOption {
+	return newRequestOption(func(r *http.Request) {
+		q := r.URL.Query()
+		q.Set("from", checkpoint)
+		r.URL.RawQuery = q.Encode()
+	})
+}
+
+// Take configures a request to limit the amount of items in the result, when
+// using checkpoint pagination.
+func Take(items int) RequestOption {
+	return newRequestOption(func(r *http.Request) {
+		q := r.URL.Query()
+		q.Set("take", strconv.FormatInt(int64(items), 10))
+		r.URL.RawQuery = q.Encode()
+	})
+}
+
 // IncludeTotals configures a request to include totals.
 func IncludeTotals(include bool) RequestOption {
 	return newRequestOption(func(r *http.Request) {
@@ -477,6 +862,9 @@ func IncludeTotals(include bool) RequestOption {
 //   List(Query(`logins_count:[100 TO 200}`))
 //   List(Query(`logins_count:{100 TO *]`))
 //
+// Queries can be built using the search package, which escapes reserved
+// characters.
+//
 // See: https://auth0.com/docs/users/search/v3/query-syntax
 func Query(s string) RequestOption {
 	return newRequestOption(func(r *http.Request) {
@@ -507,10 +895,65 @@ func Header(key, value string) RequestOption {
 // Body configures a requests body.
 func Body(b []byte) RequestOption {
 	return newRequestOption(func(r *http.Request) {
+		r.ContentLength = int64(len(b))
+		r.Body = ioutil.NopCloser(bytes.NewReader(b))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(b)), nil
+		}
+	})
+}
+
+// Null configures a request to set the provided fields to null in its JSON
+// payload, which clears them when updating a resource. Fields are named after
+// their JSON keys, and nested fields are separated by a dot.
+//
+// For example:
+//   m.Client.Update(id, &Client{}, Null("initiate_login_uri"))
+//   m.Organization.Update(id, &Organization{}, Null("branding.logo_url"))
+func Null(fields ...string) RequestOption {
+	return newRequestOption(func(r *http.Request) {
+		var b []byte
+		if r.Body != nil {
+			b, _ = ioutil.ReadAll(r.Body)
+			r.Body.Close()
+		}
+
+		payload := make(map[string]interface{})
+		if len(bytes.TrimSpace(b)) > 0 {
+			d := json.NewDecoder(bytes.NewReader(b))
+			d.UseNumber()
+			if err := d.Decode(&payload); err != nil {
+				// The payload is not a JSON object, so it is left unchanged.
+				r.Body = ioutil.NopCloser(bytes.NewReader(b))
+				return
+			}
+		}
+		for _, field := range fields {
+			setNull(payload, strings.Split(field, "."))
+		}
+
+		b, _ = json.Marshal(payload)
+		r.ContentLength = int64(len(b))
 		r.Body = ioutil.NopCloser(bytes.NewReader(b))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(b)), nil
+		}
 	})
 }
 
+func setNull(payload map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		payload[path[0]] = nil
+		return
+	}
+	child, ok := payload[path[0]].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		payload[path[0]] = child
+	}
+	setNull(child, path[1:])
+}
+
 // Stringify returns a string representation of the value passed as an argument.
 func Stringify(v interface{}) string {
 	b, err := json.MarshalIndent(v, "", "  ")
@@ -1,14 +1,19 @@
 package management
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/client"
 	"gopkg.in/auth0.v5/internal/testing/expect"
 
 	_ "github.com/joho/godotenv/autoload"
@@ -21,22 +26,100 @@ var (
 	clientID     = os.Getenv("AUTH0_CLIENT_ID")
 	clientSecret = os.Getenv("AUTH0_CLIENT_SECRET")
 	debug        = os.Getenv("AUTH0_DEBUG")
+	recording    = os.Getenv("AUTH0_HTTP_RECORDING")
 )
 
+// recorder records the interactions of the tests with the tenant when
+// AUTH0_HTTP_RECORDING is "record", and replays them without a tenant when
+// it is "replay". Only the tests run by TestReplay are in the fixture until
+// it is recorded again.
+var recorder *client.Recorder
+
+const recordingPath = "testdata/recordings/management.json"
+
+// testTime returns the time used to name the resources created by the tests,
+// which is fixed when recording or replaying so that the recorded request
+// bodies match.
+var testTime = time.Now
+
+func recordingTime() time.Time {
+	return time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
 func init() {
 	initTestManagement()
 }
 
 func initTestManagement() {
-	var err error
-	m, err = New(domain,
+	options := []ManagementOption{
 		WithClientCredentials(clientID, clientSecret),
-		WithDebug(debug == "true" || debug == "1" || debug == "on"))
+		WithDebug(debug == "true" || debug == "1" || debug == "on"),
+	}
+
+	if recording == "record" || recording == "replay" {
+		mode := client.ModeRecord
+		if recording == "replay" {
+			mode = client.ModeReplay
+			options[0] = WithStaticToken("replay")
+			if domain == "" {
+				domain = "example.auth0.com"
+			}
+		}
+		testTime = recordingTime
+		var err error
+		recorder, err = client.NewRecorder(recordingPath, mode, nil)
+		if err != nil {
+			panic(err)
+		}
+		options = append(options, WithClient(&http.Client{Transport: recorder}))
+	}
+
+	var err error
+	m, err = New(domain, options...)
 	if err != nil {
 		panic(err)
 	}
 }
 
+func TestMain(tm *testing.M) {
+	code := tm.Run()
+	if recorder != nil {
+		if err := recorder.Save(); err != nil {
+			panic(err)
+		}
+	}
+	os.Exit(code)
+}
+
+// TestReplay runs the rule and resource server tests against the interactions
+// in the fixture, so that they run without a tenant. The fixture was generated
+// against a local stand-in for the Management API rather than recorded against
+// a tenant, and only covers these two resources. Recording the tests against a tenant with
+// AUTH0_HTTP_RECORDING set to "record" replaces it with the interactions of
+// every test.
+func TestReplay(t *testing.T) {
+	if recording != "" {
+		t.Skip("the tests already run against the fixture")
+	}
+
+	rec, err := client.NewRecorder(recordingPath, client.ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func(mgmt *Management, now func() time.Time) { m, testTime = mgmt, now }(m, testTime)
+	testTime = recordingTime
+	m, err = New("example.auth0.com",
+		WithStaticToken("replay"),
+		WithClient(&http.Client{Transport: rec}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Rule", TestRule)
+	t.Run("ResourceServer", TestResourceServer)
+}
+
 func TestNew(t *testing.T) {
 	for _, domain := range []string{
 		"example.com ",
@@ -130,6 +213,23 @@ func TestOptionParameter(t *testing.T) {
 	}
 }
 
+func TestOptionNull(t *testing.T) {
+
+	c := &Client{Name: auth0.String("Test"), JWTConfiguration: &ClientJWTConfiguration{LifetimeInSeconds: auth0.Int(3600)}}
+
+	r, err := m.NewRequest("PATCH", "/", c, Null("initiate_login_uri", "jwt_configuration.scopes", "mobile.ios"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, string(b), `{"initiate_login_uri":null,"jwt_configuration":{"lifetime_in_seconds":3600,"scopes":null},"mobile":{"ios":null},"name":"Test"}`)
+	expect.Expect(t, r.ContentLength, int64(len(b)))
+}
+
 func TestOptionDefauls(t *testing.T) {
 
 	r, _ := http.NewRequest("GET", "/", nil)
@@ -195,6 +295,30 @@ func TestRequestOptionContextTimeout(t *testing.T) {
 	}
 }
 
+func TestManagerContext(t *testing.T) {
+
+	release := make(chan struct{})
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+	defer close(release)
+
+	m, err := New(s.URL, WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = m.User.ReadContext(ctx, "123", Context(context.Background()))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected err to be context.DeadlineExceeded, got %v", err)
+	}
+}
+
 func TestNew_WithInsecure(t *testing.T) {
 
 	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -219,3 +343,211 @@ func TestNew_WithInsecure(t *testing.T) {
 
 	expect.Expect(t, u.GetID(), "123")
 }
+
+func TestNew_WithRetries(t *testing.T) {
+
+	attempts := make(map[string]int)
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts[r.Method]++
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusInternalServerError)
+		case attempts[r.Method] == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case attempts[r.Method] == 2:
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Write([]byte(`{"user_id":"123"}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := New(s.URL, WithInsecure(), WithRetries(RetryPolicy{BaseDelay: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	u, err := m.User.Read("123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, u.GetID(), "123")
+	expect.Expect(t, attempts[http.MethodGet], 3)
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected to wait for Retry-After, retried after %s", elapsed)
+	}
+
+	err = m.User.Create(&User{ID: auth0.String("123")})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Status() != http.StatusInternalServerError {
+		t.Errorf("Expected a server error, got %v", err)
+	}
+	expect.Expect(t, attempts[http.MethodPost], 1)
+}
+
+func TestNew_WithRateLimiter(t *testing.T) {
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "50")
+		w.Header().Set("X-RateLimit-Remaining", "49")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.Write([]byte(`{"user_id":"123"}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := New(s.URL, WithInsecure(), WithRateLimiter())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, m.RateLimit(), RateLimit{})
+
+	if _, err := m.User.Read("123"); err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, m.RateLimit(), RateLimit{
+		Limit:     50,
+		Remaining: 49,
+		Reset:     time.Unix(1700000000, 0),
+	})
+}
+
+func TestNew_WithTokenCache(t *testing.T) {
+
+	var tokenRequests int
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			tokenRequests++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":86400}`))
+		case "/api/v2/users/123":
+			w.Write([]byte(`{"user_id":"123"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	cache := NewMemoryTokenCache()
+	for i := 0; i < 2; i++ {
+		m, err := New(s.URL,
+			WithInsecure(),
+			WithClientCredentials("client-id", "client-secret"),
+			WithTokenCache(cache))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := m.User.Read("123"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expect.Expect(t, tokenRequests, 1)
+}
+
+func TestNew_WithDebugWriter(t *testing.T) {
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"user_id":"123"}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	var out bytes.Buffer
+	m, err := New(s.URL,
+		WithStaticToken("secret-token"),
+		WithInsecure(),
+		WithDebugWriter(&out),
+		WithDebugRedactedFields("nickname"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = m.User.Create(&User{
+		Connection: auth0.String("Username-Password-Authentication"),
+		Password:   auth0.String("secret-password"),
+		Nickname:   auth0.String("secret-nickname"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"insecure", "secret-password", "secret-nickname"} {
+		if strings.Contains(out.String(), secret) {
+			t.Errorf("expected %q to be redacted from:\n%s", secret, out.String())
+		}
+	}
+	if !strings.Contains(out.String(), "Username-Password-Authentication") {
+		t.Errorf("expected the request to be dumped, got:\n%s", out.String())
+	}
+}
+
+func TestAPIError(t *testing.T) {
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/users/404":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"The user does not exist.","errorCode":"inexistent_user"}`))
+		case "/api/v2/users/403":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"statusCode":403,"error":"Forbidden","message":"Insufficient scope, expected any of: read:users","errorCode":"insufficient_scope"}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html>Bad Gateway</html>"))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := New(s.URL, WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := m.User.Read("404")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected err to be ErrNotFound, got %v", err)
+		}
+		if errors.Is(err, ErrConflict) {
+			t.Errorf("expected err not to be ErrConflict")
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected err to be an *APIError, got %T", err)
+		}
+		expect.Expect(t, apiErr.Status(), http.StatusNotFound)
+		expect.Expect(t, apiErr.ErrorCode, "inexistent_user")
+		expect.Expect(t, apiErr.Method, "GET")
+		expect.Expect(t, apiErr.URL, s.URL+"/api/v2/users/404")
+		expect.Expect(t, apiErr.Error(), "404 Not Found: The user does not exist.")
+	})
+
+	t.Run("InsufficientScope", func(t *testing.T) {
+		_, err := m.User.Read("403")
+		if !errors.Is(err, ErrInsufficientScope) {
+			t.Errorf("expected err to be ErrInsufficientScope, got %v", err)
+		}
+		if !errors.Is(err, ErrForbidden) {
+			t.Errorf("expected err to be ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("UnexpectedBody", func(t *testing.T) {
+		_, err := m.User.Read("502")
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected err to be an *APIError, got %T", err)
+		}
+		expect.Expect(t, apiErr.Status(), http.StatusBadGateway)
+		expect.Expect(t, apiErr.Message, "<html>Bad Gateway</html>")
+		expect.Expect(t, string(apiErr.Body), "<html>Bad Gateway</html>")
+	})
+}
@@ -0,0 +1,212 @@
+package managementtest
+
+import (
+	"net/http"
+)
+
+// triggers are the triggers supported by the fake.
+var triggers = []object{
+	{"id": "post-login", "version": "v2", "status": "CURRENT"},
+	{"id": "credentials-exchange", "version": "v1", "status": "CURRENT"},
+	{"id": "pre-user-registration", "version": "v1", "status": "CURRENT"},
+	{"id": "post-user-registration", "version": "v1", "status": "CURRENT"},
+	{"id": "post-change-password", "version": "v1", "status": "CURRENT"},
+	{"id": "send-phone-message", "version": "v1", "status": "CURRENT"},
+}
+
+func (s *Server) handleActions(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) == 0 {
+		notFound(w, "Not Found")
+		return
+	}
+	switch path[0] {
+	case "actions":
+		s.handleActionsActions(w, r, path[1:])
+	case "triggers":
+		s.handleActionsTriggers(w, r, path[1:])
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) handleActionsActions(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeList(w, r, "actions", s.actions.filter(nil), true)
+
+	case len(path) == 0 && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if o.string("name") == "" || o["supported_triggers"] == nil {
+			badRequest(w, "Payload validation error: 'Missing required property: name, supported_triggers'.")
+			return
+		}
+		delete(o, "id")
+		o["status"] = "built"
+		o["all_changes_deployed"] = false
+		o["created_at"] = now()
+		o["updated_at"] = o["created_at"]
+		writeObject(w, r, http.StatusCreated, s.actions.insert(o))
+
+	case len(path) >= 1:
+		a, ok := s.actions.get(path[0])
+		if !ok {
+			notFound(w, "That action does not exist.")
+			return
+		}
+		versions, ok := s.versions[path[0]]
+		if !ok {
+			versions = newCollection("id", "")
+			s.versions[path[0]] = versions
+		}
+
+		switch {
+		case len(path) == 1 && r.Method == http.MethodGet:
+			writeObject(w, r, http.StatusOK, a)
+		case len(path) == 1 && r.Method == http.MethodPatch:
+			o, ok := decodeObject(w, r)
+			if !ok {
+				return
+			}
+			delete(o, "id")
+			a.merge(o)
+			a["all_changes_deployed"] = false
+			a["updated_at"] = now()
+			writeObject(w, r, http.StatusOK, a)
+		case len(path) == 1 && r.Method == http.MethodDelete:
+			s.actions.remove(path[0])
+			delete(s.versions, path[0])
+			for trigger := range s.bindings {
+				s.unbind(trigger, path[0])
+			}
+			noContent(w)
+		case len(path) == 2 && path[1] == "deploy" && r.Method == http.MethodPost:
+			writeObject(w, r, http.StatusOK, s.deploy(a, versions, nil))
+		case len(path) == 2 && path[1] == "versions" && r.Method == http.MethodGet:
+			writeList(w, r, "versions", versions.filter(nil), true)
+		case len(path) >= 3 && path[1] == "versions":
+			v, ok := versions.get(path[2])
+			if !ok {
+				notFound(w, "That action version does not exist.")
+				return
+			}
+			switch {
+			case len(path) == 3 && r.Method == http.MethodGet:
+				writeObject(w, r, http.StatusOK, v)
+			case len(path) == 4 && path[3] == "deploy" && r.Method == http.MethodPost:
+				writeObject(w, r, http.StatusOK, s.deploy(a, versions, v))
+			default:
+				notFound(w, "Not Found")
+			}
+		default:
+			notFound(w, "Not Found")
+		}
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+// deploy deploys version v of action a. If v is nil a new version is created
+// from the current state of the action.
+func (s *Server) deploy(a object, versions *collection, v object) object {
+	if v == nil {
+		v = object{
+			"code":         a["code"],
+			"dependencies": a["dependencies"],
+			"runtime":      a["runtime"],
+			"secrets":      a["secrets"],
+			"status":       "built",
+			"number":       len(versions.ids) + 1,
+			"created_at":   now(),
+			"action":       pick(a, "id", "name", "supported_triggers"),
+		}
+		versions.insert(v)
+	}
+	for _, other := range versions.filter(nil) {
+		other["deployed"] = false
+	}
+	v["deployed"] = true
+	v["updated_at"] = now()
+	a["deployed_version"] = v.clone()
+	a["all_changes_deployed"] = true
+	return v
+}
+
+func (s *Server) handleActionsTriggers(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, object{"triggers": triggers})
+
+	case len(path) == 2 && path[1] == "bindings":
+		var supported bool
+		for _, t := range triggers {
+			if t.string("id") == path[0] {
+				supported = true
+			}
+		}
+		if !supported {
+			notFound(w, "That trigger does not exist.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeList(w, r, "bindings", s.bindings[path[0]], true)
+		case http.MethodPatch:
+			var body struct {
+				Bindings []object `json:"bindings"`
+			}
+			if err := decode(r, &body); err != nil {
+				badRequest(w, err.Error())
+				return
+			}
+			bindings := make([]object, 0, len(body.Bindings))
+			for _, b := range body.Bindings {
+				ref, _ := b["ref"].(map[string]interface{})
+				a, ok := s.actions.find(func(a object) bool {
+					switch ref["type"] {
+					case "action_id":
+						return a.string("id") == ref["value"]
+					case "action_name":
+						return a.string("name") == ref["value"]
+					}
+					return false
+				})
+				if !ok {
+					notFound(w, "That action does not exist.")
+					return
+				}
+				bindings = append(bindings, object{
+					"id":           randomID(32),
+					"trigger_id":   path[0],
+					"display_name": b["display_name"],
+					"secrets":      b["secrets"],
+					"action":       pick(a, "id", "name", "supported_triggers"),
+					"created_at":   now(),
+					"updated_at":   now(),
+				})
+			}
+			s.bindings[path[0]] = bindings
+			writeJSON(w, http.StatusOK, object{"bindings": bindings})
+		default:
+			notFound(w, "Not Found")
+		}
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+// unbind removes any binding of the action identified by id from trigger.
+func (s *Server) unbind(trigger, id string) {
+	bindings := s.bindings[trigger][:0]
+	for _, b := range s.bindings[trigger] {
+		if a, _ := b["action"].(object); a.string("id") == id {
+			continue
+		}
+		bindings = append(bindings, b)
+	}
+	s.bindings[trigger] = bindings
+}
@@ -0,0 +1,136 @@
+package managementtest
+
+import (
+	"net/http"
+)
+
+func (s *Server) handleClients(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeList(w, r, "clients", s.clients.filter(nil), false)
+
+	case len(path) == 0 && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if o.string("name") == "" {
+			badRequest(w, "Payload validation error: 'Missing required property: name'.")
+			return
+		}
+		delete(o, "client_id")
+		o["client_id"] = randomID(32)
+		o["client_secret"] = randomID(64)
+		writeObject(w, r, http.StatusCreated, s.clients.insert(o))
+
+	case len(path) == 1:
+		c, ok := s.clients.get(path[0])
+		if !ok {
+			notFound(w, "The client does not exist")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeObject(w, r, http.StatusOK, c)
+		case http.MethodPatch:
+			o, ok := decodeObject(w, r)
+			if !ok {
+				return
+			}
+			delete(o, "client_id")
+			c.merge(o, "client_metadata")
+			writeObject(w, r, http.StatusOK, c)
+		case http.MethodDelete:
+			s.clients.remove(path[0])
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	case len(path) == 2 && path[1] == "rotate-secret" && r.Method == http.MethodPost:
+		c, ok := s.clients.get(path[0])
+		if !ok {
+			notFound(w, "The client does not exist")
+			return
+		}
+		c["client_secret"] = randomID(64)
+		writeObject(w, r, http.StatusOK, c)
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) handleConnections(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		q := r.URL.Query()
+		writeList(w, r, "connections", s.connections.filter(func(o object) bool {
+			if name := q.Get("name"); name != "" && o.string("name") != name {
+				return false
+			}
+			if strategy := q.Get("strategy"); strategy != "" && o.string("strategy") != strategy {
+				return false
+			}
+			return true
+		}), false)
+
+	case len(path) == 0 && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		name := o.string("name")
+		if name == "" || o.string("strategy") == "" {
+			badRequest(w, "Payload validation error: 'Missing required property: name, strategy'.")
+			return
+		}
+		if _, exists := s.connectionByName(name); exists {
+			conflict(w, "A connection with the same name already exists")
+			return
+		}
+		delete(o, "id")
+		if o["options"] == nil {
+			o["options"] = map[string]interface{}{}
+		}
+		writeObject(w, r, http.StatusCreated, s.connections.insert(o))
+
+	case len(path) == 1:
+		c, ok := s.connections.get(path[0])
+		if !ok {
+			notFound(w, "The connection does not exist")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeObject(w, r, http.StatusOK, c)
+		case http.MethodPatch:
+			o, ok := decodeObject(w, r)
+			if !ok {
+				return
+			}
+			for _, readOnly := range []string{"id", "name", "strategy"} {
+				if _, ok := o[readOnly]; ok {
+					badRequest(w, "Payload validation error: 'Additional properties not allowed: "+readOnly+"'.")
+					return
+				}
+			}
+			c.merge(o)
+			writeObject(w, r, http.StatusOK, c)
+		case http.MethodDelete:
+			s.connections.remove(path[0])
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) connectionByName(name string) (object, bool) {
+	return s.connections.find(func(o object) bool {
+		return o.string("name") == name
+	})
+}
@@ -0,0 +1,162 @@
+package managementtest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+func (s *Server) handleLogs(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		if isCheckpoint(r) {
+			writeCheckpoint(w, r, "logs", "log_id", s.logs.filter(nil), false)
+			return
+		}
+		writeList(w, r, "logs", s.logs.filter(nil), false)
+
+	case len(path) == 1 && r.Method == http.MethodGet:
+		l, ok := s.logs.get(path[0])
+		if !ok {
+			notFound(w, "log not found")
+			return
+		}
+		writeObject(w, r, http.StatusOK, l)
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+// handleJobs emulates the jobs endpoints. Jobs are created with a "pending"
+// status and are reported as "completed" the next time they are read.
+func (s *Server) handleJobs(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 1 && path[0] == "verification-email" && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if _, ok := s.users.get(o.string("user_id")); !ok {
+			writeError(w, http.StatusNotFound, "inexistent_user", "The user does not exist.")
+			return
+		}
+		writeObject(w, r, http.StatusCreated, s.newJob("verification_email", o))
+
+	case len(path) == 1 && path[0] == "users-exports" && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if id := o.string("connection_id"); id != "" {
+			if _, ok := s.connections.get(id); !ok {
+				notFound(w, "The connection does not exist")
+				return
+			}
+		}
+		if o.string("format") == "" {
+			o["format"] = "json"
+		}
+		writeObject(w, r, http.StatusCreated, s.newJob("users_export", o))
+
+	case len(path) == 1 && path[0] == "users-imports" && r.Method == http.MethodPost:
+		s.importUsers(w, r)
+
+	case len(path) == 1 && r.Method == http.MethodGet:
+		j, ok := s.jobs.get(path[0])
+		if !ok {
+			notFound(w, "The job does not exist.")
+			return
+		}
+		if j.string("status") == "pending" {
+			j["status"] = "completed"
+		}
+		writeObject(w, r, http.StatusOK, j)
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) newJob(typ string, o object) object {
+	delete(o, "id")
+	o["type"] = typ
+	o["status"] = "pending"
+	o["created_at"] = now()
+	return s.jobs.insert(o)
+}
+
+// importUsers handles a multipart users import request, creating the users in
+// the target connection right away.
+func (s *Server) importUsers(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		badRequest(w, err.Error())
+		return
+	}
+	connectionID := r.FormValue("connection_id")
+	c, ok := s.connections.get(connectionID)
+	if !ok {
+		notFound(w, "The connection does not exist")
+		return
+	}
+	f, _, err := r.FormFile("users")
+	if err != nil {
+		badRequest(w, "Payload validation error: 'Missing required property: users'.")
+		return
+	}
+	defer f.Close()
+
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		badRequest(w, err.Error())
+		return
+	}
+	var users []object
+	if err := json.Unmarshal(b, &users); err != nil {
+		badRequest(w, "Users file must be an array of users")
+		return
+	}
+
+	upsert := r.FormValue("upsert") == "true"
+	var inserted, updated int
+	var failures []object
+	for _, u := range users {
+		u["connection"] = c.string("name")
+		if upsert {
+			if existing, ok := s.users.find(func(o object) bool {
+				return o.string("connection") == c.string("name") && o.string("email") == u.string("email")
+			}); ok {
+				delete(u, "user_id")
+				existing.merge(u, "app_metadata", "user_metadata")
+				existing["updated_at"] = now()
+				updated++
+				continue
+			}
+		}
+		user := u.clone()
+		if status, code, message := s.createUser(user); status != http.StatusCreated {
+			failures = append(failures, object{
+				"user":   u,
+				"errors": []object{{"code": code, "message": message}},
+			})
+			continue
+		}
+		inserted++
+	}
+
+	j := s.newJob("users_import", object{
+		"connection_id": connectionID,
+		"connection":    c.string("name"),
+		"summary": object{
+			"failed":   len(failures),
+			"updated":  updated,
+			"inserted": inserted,
+			"total":    len(users),
+		},
+	})
+	if id := r.FormValue("external_id"); id != "" {
+		j["external_id"] = id
+	}
+	s.jobErrors[j.string("id")] = failures
+	writeObject(w, r, http.StatusCreated, j)
+}
@@ -0,0 +1,285 @@
+package managementtest
+
+import (
+	"net/http"
+)
+
+func (s *Server) handleOrganizations(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeList(w, r, "organizations", s.organizations.filter(nil), false)
+
+	case len(path) == 0 && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		name := o.string("name")
+		if name == "" {
+			badRequest(w, "Payload validation error: 'Missing required property: name'.")
+			return
+		}
+		if _, exists := s.organizationByName(name); exists {
+			conflict(w, "An organization with this name already exists.")
+			return
+		}
+		delete(o, "id")
+		writeObject(w, r, http.StatusCreated, s.organizations.insert(o))
+
+	case len(path) == 2 && path[0] == "name" && r.Method == http.MethodGet:
+		o, ok := s.organizationByName(path[1])
+		if !ok {
+			notFound(w, "No organization found by that name.")
+			return
+		}
+		writeObject(w, r, http.StatusOK, o)
+
+	case len(path) >= 1:
+		org, ok := s.organizations.get(path[0])
+		if !ok {
+			notFound(w, "No organization found by that id.")
+			return
+		}
+		if len(path) == 1 {
+			s.handleOrganization(w, r, org)
+			return
+		}
+		switch path[1] {
+		case "enabled_connections":
+			s.handleOrganizationConnections(w, r, org, path[2:])
+		case "invitations":
+			s.handleOrganizationInvitations(w, r, org, path[2:])
+		case "members":
+			s.handleOrganizationMembers(w, r, org, path[2:])
+		default:
+			notFound(w, "Not Found")
+		}
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) handleOrganization(w http.ResponseWriter, r *http.Request, org object) {
+	id := org.string("id")
+	switch r.Method {
+	case http.MethodGet:
+		writeObject(w, r, http.StatusOK, org)
+	case http.MethodPatch:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		delete(o, "id")
+		org.merge(o)
+		writeObject(w, r, http.StatusOK, org)
+	case http.MethodDelete:
+		for _, member := range s.members.of(id).sorted() {
+			delete(s.memberRoles, id+"/"+member)
+		}
+		s.organizations.remove(id)
+		delete(s.members, id)
+		delete(s.orgConnections, id)
+		delete(s.invitations, id)
+		noContent(w)
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) handleOrganizationConnections(w http.ResponseWriter, r *http.Request, org object, path []string) {
+	id := org.string("id")
+	connections, ok := s.orgConnections[id]
+	if !ok {
+		connections = newCollection("connection_id", "")
+		s.orgConnections[id] = connections
+	}
+
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeList(w, r, "enabled_connections", connections.filter(nil), false)
+
+	case len(path) == 0 && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		c, ok := s.connections.get(o.string("connection_id"))
+		if !ok {
+			notFound(w, "The connection does not exist")
+			return
+		}
+		if _, exists := connections.get(o.string("connection_id")); exists {
+			conflict(w, "The connection is already enabled for this organization.")
+			return
+		}
+		if _, ok := o["assign_membership_on_login"]; !ok {
+			o["assign_membership_on_login"] = false
+		}
+		o["connection"] = pick(c, "name", "strategy")
+		writeObject(w, r, http.StatusCreated, connections.insert(o))
+
+	case len(path) == 1:
+		c, ok := connections.get(path[0])
+		if !ok {
+			notFound(w, "The connection is not enabled for this organization.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeObject(w, r, http.StatusOK, c)
+		case http.MethodPatch:
+			o, ok := decodeObject(w, r)
+			if !ok {
+				return
+			}
+			delete(o, "connection_id")
+			delete(o, "connection")
+			c.merge(o)
+			writeObject(w, r, http.StatusOK, c)
+		case http.MethodDelete:
+			connections.remove(path[0])
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) handleOrganizationInvitations(w http.ResponseWriter, r *http.Request, org object, path []string) {
+	id := org.string("id")
+	invitations, ok := s.invitations[id]
+	if !ok {
+		invitations = newCollection("id", "uinv_")
+		s.invitations[id] = invitations
+	}
+
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeList(w, r, "invitations", invitations.filter(nil), false)
+
+	case len(path) == 0 && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if o["inviter"] == nil || o["invitee"] == nil || o.string("client_id") == "" {
+			badRequest(w, "Payload validation error: 'Missing required property: inviter, invitee, client_id'.")
+			return
+		}
+		delete(o, "id")
+		o["organization_id"] = id
+		o["created_at"] = now()
+		o["ticket_id"] = randomID(32)
+		o["invitation_url"] = "https://example.com/login?invitation=" + o.string("ticket_id")
+		writeObject(w, r, http.StatusCreated, invitations.insert(o))
+
+	case len(path) == 1:
+		i, ok := invitations.get(path[0])
+		if !ok {
+			notFound(w, "The invitation does not exist.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeObject(w, r, http.StatusOK, i)
+		case http.MethodDelete:
+			invitations.remove(path[0])
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) handleOrganizationMembers(w http.ResponseWriter, r *http.Request, org object, path []string) {
+	id := org.string("id")
+	members := s.members.of(id)
+
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		var l []object
+		for _, u := range s.users.filter(nil) {
+			if _, ok := members[u.string("user_id")]; ok {
+				l = append(l, pick(u, "user_id", "email", "name", "picture"))
+			}
+		}
+		if isCheckpoint(r) {
+			writeCheckpoint(w, r, "members", "user_id", l, true)
+			return
+		}
+		writeList(w, r, "members", l, false)
+
+	case len(path) == 0 && (r.Method == http.MethodPost || r.Method == http.MethodDelete):
+		ids, ok := decodeIDs(w, r, "members")
+		if !ok {
+			return
+		}
+		if r.Method == http.MethodPost {
+			for _, uid := range ids {
+				if _, ok := s.users.get(uid); !ok {
+					writeError(w, http.StatusNotFound, "inexistent_user", "The user does not exist.")
+					return
+				}
+			}
+			members.add(ids...)
+		} else {
+			members.remove(ids...)
+			for _, uid := range ids {
+				delete(s.memberRoles, id+"/"+uid)
+			}
+		}
+		noContent(w)
+
+	case len(path) == 2 && path[1] == "roles":
+		if _, ok := members[path[0]]; !ok {
+			notFound(w, "The user is not a member of this organization.")
+			return
+		}
+		roles := s.memberRoles.of(id + "/" + path[0])
+		switch r.Method {
+		case http.MethodGet:
+			var l []object
+			for _, rid := range roles.sorted() {
+				if role, ok := s.roles.get(rid); ok {
+					l = append(l, pick(role, "id", "name", "description"))
+				}
+			}
+			writeList(w, r, "roles", l, false)
+		case http.MethodPost, http.MethodDelete:
+			ids, ok := decodeIDs(w, r, "roles")
+			if !ok {
+				return
+			}
+			if r.Method == http.MethodPost {
+				for _, rid := range ids {
+					if _, ok := s.roles.get(rid); !ok {
+						notFound(w, "The role does not exist.")
+						return
+					}
+				}
+				roles.add(ids...)
+			} else {
+				roles.remove(ids...)
+			}
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) organizationByName(name string) (object, bool) {
+	return s.organizations.find(func(o object) bool {
+		return o.string("name") == name
+	})
+}
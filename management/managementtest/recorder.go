@@ -0,0 +1,45 @@
+package managementtest
+
+import (
+	"net/http"
+
+	"gopkg.in/auth0.v5/internal/client"
+)
+
+// Recorder is an http.RoundTripper recording interactions with the Auth0
+// Management API to a fixture file, or replaying them from it, so that tests
+// recorded once against a tenant can then run without one.
+//
+//     rec, err := managementtest.NewRecorder("testdata/users.json", managementtest.ModeReplay, nil)
+//     if err != nil {
+//         // handle err
+//     }
+//     defer rec.Save()
+//
+//     m, err := management.New(domain,
+//         management.WithStaticToken("replay"),
+//         management.WithClient(&http.Client{Transport: rec}))
+//
+// Requests are matched on their method, path, normalized query and body, and
+// identical requests are replayed in the order they were recorded. The
+// "Authorization" header and sensitive fields, such as passwords and client
+// secrets, are redacted before fixtures are saved.
+type Recorder = client.Recorder
+
+// RecorderMode determines whether a Recorder records or replays interactions.
+type RecorderMode = client.RecorderMode
+
+const (
+	// ModeReplay replays recorded interactions without sending requests.
+	ModeReplay = client.ModeReplay
+
+	// ModeRecord sends requests and records the interactions.
+	ModeRecord = client.ModeRecord
+)
+
+// NewRecorder returns a Recorder using the fixture file at path. Requests are
+// sent using the base transport when recording, which defaults to
+// http.DefaultTransport. Recorded interactions are written by Save.
+func NewRecorder(path string, mode RecorderMode, base http.RoundTripper) (*Recorder, error) {
+	return client.NewRecorder(path, mode, base)
+}
@@ -0,0 +1,147 @@
+package managementtest
+
+import (
+	"net/http"
+	"strings"
+)
+
+func (s *Server) handleRoles(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		filter := strings.ToLower(r.URL.Query().Get("name_filter"))
+		writeList(w, r, "roles", s.roles.filter(func(o object) bool {
+			return strings.Contains(strings.ToLower(o.string("name")), filter)
+		}), false)
+
+	case len(path) == 0 && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		name := o.string("name")
+		if name == "" {
+			badRequest(w, "Payload validation error: 'Missing required property: name'.")
+			return
+		}
+		if _, exists := s.roles.find(func(role object) bool {
+			return role.string("name") == name
+		}); exists {
+			conflict(w, "Role already exists")
+			return
+		}
+		delete(o, "id")
+		writeObject(w, r, http.StatusOK, s.roles.insert(o))
+
+	case len(path) == 1:
+		role, ok := s.roles.get(path[0])
+		if !ok {
+			notFound(w, "The role does not exist.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeObject(w, r, http.StatusOK, role)
+		case http.MethodPatch:
+			o, ok := decodeObject(w, r)
+			if !ok {
+				return
+			}
+			delete(o, "id")
+			role.merge(o)
+			writeObject(w, r, http.StatusOK, role)
+		case http.MethodDelete:
+			s.roles.remove(path[0])
+			s.userRoles.unlink(path[0])
+			delete(s.rolePermissions, path[0])
+			for _, roles := range s.memberRoles {
+				roles.remove(path[0])
+			}
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	case len(path) == 2 && path[1] == "users":
+		if _, ok := s.roles.get(path[0]); !ok {
+			notFound(w, "The role does not exist.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			var users []object
+			for _, u := range s.users.filter(nil) {
+				if _, ok := s.userRoles.of(u.string("user_id"))[path[0]]; ok {
+					users = append(users, pick(u, "user_id", "email", "name", "picture"))
+				}
+			}
+			writeList(w, r, "users", users, false)
+		case http.MethodPost:
+			ids, ok := decodeIDs(w, r, "users")
+			if !ok {
+				return
+			}
+			for _, id := range ids {
+				if _, ok := s.users.get(id); !ok {
+					writeError(w, http.StatusNotFound, "inexistent_user", "The user does not exist.")
+					return
+				}
+			}
+			for _, id := range ids {
+				s.userRoles.of(id).add(path[0])
+			}
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	case len(path) == 2 && path[1] == "permissions":
+		if _, ok := s.roles.get(path[0]); !ok {
+			notFound(w, "The role does not exist.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeList(w, r, "permissions", s.rolePermissions[path[0]], false)
+		case http.MethodPost, http.MethodDelete:
+			var body struct {
+				Permissions []object `json:"permissions"`
+			}
+			if err := decode(r, &body); err != nil {
+				badRequest(w, err.Error())
+				return
+			}
+			if len(body.Permissions) == 0 {
+				badRequest(w, "Payload validation error: 'Missing required property: permissions'.")
+				return
+			}
+			for _, p := range body.Permissions {
+				s.removePermission(path[0], p)
+				if r.Method == http.MethodPost {
+					s.rolePermissions[path[0]] = append(s.rolePermissions[path[0]], p)
+				}
+			}
+			if r.Method == http.MethodPost {
+				writeJSON(w, http.StatusCreated, object{})
+				return
+			}
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) removePermission(roleID string, p object) {
+	permissions := s.rolePermissions[roleID][:0]
+	for _, q := range s.rolePermissions[roleID] {
+		if q.string("permission_name") == p.string("permission_name") &&
+			q.string("resource_server_identifier") == p.string("resource_server_identifier") {
+			continue
+		}
+		permissions = append(permissions, q)
+	}
+	s.rolePermissions[roleID] = permissions
+}
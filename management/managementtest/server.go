@@ -0,0 +1,459 @@
+// Package managementtest provides an in-memory fake of the Auth0 Management
+// API, for testing code built on the management package without access to an
+// Auth0 tenant.
+//
+// The fake keeps state between requests, so resources created through a
+// management client can be read, listed, updated and deleted afterwards.
+//
+//     s := managementtest.NewServer()
+//     defer s.Close()
+//
+//     m, err := s.Management()
+//     if err != nil {
+//         // handle err
+//     }
+//
+//     err = m.Client.Create(&management.Client{Name: auth0.String("test")})
+//
+// Clients, connections, users, roles, organizations, actions, logs and jobs
+// are emulated. Requests to any other endpoint respond with 404 Not Found.
+package managementtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+// Server is a stateful fake of the Auth0 Management API listening on a local
+// loopback address.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	routes      map[string]func(w http.ResponseWriter, r *http.Request, path []string)
+	rateLimited int
+
+	clients       *collection
+	connections   *collection
+	users         *collection
+	roles         *collection
+	organizations *collection
+	actions       *collection
+	logs          *collection
+	jobs          *collection
+
+	userRoles       relation
+	rolePermissions map[string][]object
+	members         relation
+	memberRoles     relation
+	orgConnections  map[string]*collection
+	invitations     map[string]*collection
+	versions        map[string]*collection
+	bindings        map[string][]object
+	jobErrors       map[string][]object
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when
+// finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		clients:       newCollection("client_id", ""),
+		connections:   newCollection("id", "con_"),
+		users:         newCollection("user_id", "auth0|"),
+		roles:         newCollection("id", "rol_"),
+		organizations: newCollection("id", "org_"),
+		actions:       newCollection("id", ""),
+		logs:          newCollection("log_id", ""),
+		jobs:          newCollection("id", "job_"),
+
+		userRoles:       make(relation),
+		rolePermissions: make(map[string][]object),
+		members:         make(relation),
+		memberRoles:     make(relation),
+		orgConnections:  make(map[string]*collection),
+		invitations:     make(map[string]*collection),
+		versions:        make(map[string]*collection),
+		bindings:        make(map[string][]object),
+		jobErrors:       make(map[string][]object),
+	}
+	s.routes = map[string]func(w http.ResponseWriter, r *http.Request, path []string){
+		"clients":        s.handleClients,
+		"connections":    s.handleConnections,
+		"users":          s.handleUsers,
+		"users-by-email": s.handleUsersByEmail,
+		"roles":          s.handleRoles,
+		"organizations":  s.handleOrganizations,
+		"actions":        s.handleActions,
+		"logs":           s.handleLogs,
+		"jobs":           s.handleJobs,
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Domain returns the host and port the server is listening on, suitable for
+// passing to management.New.
+func (s *Server) Domain() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// Management returns a management client configured to send requests to the
+// server. Any options are applied after management.WithInsecure.
+func (s *Server) Management(options ...management.ManagementOption) (*management.Management, error) {
+	return management.New(s.Domain(), append([]management.ManagementOption{
+		management.WithInsecure(),
+	}, options...)...)
+}
+
+// RateLimit causes the next n requests to be rejected with a 429 Too Many
+// Requests status code.
+func (s *Server) RateLimit(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimited = n
+}
+
+// AddLogs adds log events to the tenant, which can later be retrieved using
+// the LogManager.
+func (s *Server) AddLogs(logs ...*management.Log) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, l := range logs {
+		o := toObject(l)
+		if o.string("log_id") == "" {
+			o["log_id"] = randomID(56)
+		}
+		o["_id"] = o["log_id"]
+		if o["date"] == nil {
+			o["date"] = now()
+		}
+		s.logs.insert(o)
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.rateLimited > 0 {
+		s.rateLimited--
+		w.Header().Set("X-RateLimit-Limit", "50")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+		writeError(w, http.StatusTooManyRequests, "too_many_requests", "Global limit has been reached")
+		return
+	}
+
+	if r.URL.Path == "/oauth/token" {
+		s.handleToken(w, r)
+		return
+	}
+
+	p := strings.TrimPrefix(r.URL.Path, "/api/v2/")
+	if p == r.URL.Path {
+		notFound(w, "Not Found")
+		return
+	}
+	path := strings.Split(strings.Trim(p, "/"), "/")
+
+	h, ok := s.routes[path[0]]
+	if !ok {
+		notFound(w, "Not Found")
+		return
+	}
+	h(w, r, path[1:])
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		notFound(w, "Not Found")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		writeError(w, http.StatusForbidden, "unauthorized_client", "Grant type not allowed for the client.")
+		return
+	}
+	writeJSON(w, http.StatusOK, object{
+		"access_token": randomID(64),
+		"token_type":   "Bearer",
+		"expires_in":   86400,
+	})
+}
+
+// managementError is the error payload sent by the Management API.
+type managementError struct {
+	StatusCode int    `json:"statusCode"`
+	Err        string `json:"error"`
+	Message    string `json:"message"`
+	ErrorCode  string `json:"errorCode,omitempty"`
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, &managementError{
+		StatusCode: status,
+		Err:        http.StatusText(status),
+		Message:    message,
+		ErrorCode:  code,
+	})
+}
+
+func notFound(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusNotFound, "inexistent_resource", message)
+}
+
+func badRequest(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusBadRequest, "invalid_body", message)
+}
+
+func conflict(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusConflict, "already_exists", message)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func noContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeObject writes o to w, honoring the fields and include_fields query
+// parameters.
+func writeObject(w http.ResponseWriter, r *http.Request, status int, o object) {
+	writeJSON(w, status, view(r, o))
+}
+
+// writeList writes a page of items to w, honoring the page, per_page and
+// include_totals query parameters. Endpoints which always respond with an
+// envelope, such as the actions endpoints, set envelope to true.
+func writeList(w http.ResponseWriter, r *http.Request, key string, items []object, envelope bool) {
+	q := r.URL.Query()
+
+	page, err := intParam(q.Get("page"), 0)
+	if err != nil || page < 0 {
+		badRequest(w, "Query validation error: 'page' must be a positive integer")
+		return
+	}
+	perPage, err := intParam(q.Get("per_page"), 50)
+	if err != nil || perPage < 0 || perPage > 100 {
+		badRequest(w, "Query validation error: 'per_page' must be an integer between 0 and 100")
+		return
+	}
+
+	start := page * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	out := make([]object, 0, end-start)
+	for _, o := range items[start:end] {
+		out = append(out, view(r, o))
+	}
+
+	if envelope || q.Get("include_totals") == "true" {
+		writeJSON(w, http.StatusOK, object{
+			"start":  start,
+			"limit":  perPage,
+			"length": len(out),
+			"total":  len(items),
+			key:      out,
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// writeCheckpoint writes a page of items to w using checkpoint pagination,
+// honoring the from and take query parameters.
+//
+// When envelope is false the response is a plain array and from is the id of
+// the last item of the previous page, as is the case for logs. Otherwise the
+// items are wrapped in an envelope and from is the opaque value of the next
+// property of the previous response.
+func writeCheckpoint(w http.ResponseWriter, r *http.Request, key, idKey string, items []object, envelope bool) {
+	q := r.URL.Query()
+
+	take, err := intParam(q.Get("take"), 50)
+	if err != nil || take < 1 || take > 100 {
+		badRequest(w, "Query validation error: 'take' must be an integer between 1 and 100")
+		return
+	}
+
+	index := func(id string) int {
+		for i, o := range items {
+			if o.string(idKey) == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var start int
+	if from := q.Get("from"); from != "" {
+		if envelope {
+			id, err := base64.RawURLEncoding.DecodeString(from)
+			if err != nil {
+				badRequest(w, "Query validation error: 'from' is invalid")
+				return
+			}
+			from = string(id)
+		}
+		start = index(from)
+		if start == -1 {
+			badRequest(w, "Query validation error: 'from' is invalid")
+			return
+		}
+		if !envelope {
+			start++
+		}
+	}
+	end := start + take
+	if end > len(items) {
+		end = len(items)
+	}
+
+	out := make([]object, 0, end-start)
+	for _, o := range items[start:end] {
+		out = append(out, view(r, o))
+	}
+
+	if !envelope {
+		writeJSON(w, http.StatusOK, out)
+		return
+	}
+	body := object{key: out}
+	if end < len(items) {
+		body["next"] = base64.RawURLEncoding.EncodeToString([]byte(items[end].string(idKey)))
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// isCheckpoint returns true if r requests checkpoint pagination.
+func isCheckpoint(r *http.Request) bool {
+	q := r.URL.Query()
+	return q.Get("from") != "" || q.Get("take") != ""
+}
+
+// view returns a copy of o restricted to the fields requested by r.
+func view(r *http.Request, o object) object {
+	q := r.URL.Query()
+	fields := q.Get("fields")
+	if fields == "" {
+		return o.clone()
+	}
+	include := q.Get("include_fields") != "false"
+	selected := make(map[string]bool)
+	for _, f := range strings.Split(fields, ",") {
+		selected[strings.TrimSpace(f)] = true
+	}
+	v := make(object)
+	for k, val := range o {
+		if selected[k] == include {
+			v[k] = val
+		}
+	}
+	return v
+}
+
+func intParam(s string, def int) (int, error) {
+	if s == "" {
+		return def, nil
+	}
+	return strconv.Atoi(s)
+}
+
+var errInvalidJSON = errors.New("Invalid request payload JSON format")
+
+// decode reads the JSON request body into v. An empty or null body leaves v
+// untouched.
+func decode(r *http.Request, v interface{}) error {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errInvalidJSON
+	}
+	return nil
+}
+
+// decodeObject decodes the JSON request body, writing a 400 response and
+// returning false if that is not possible.
+func decodeObject(w http.ResponseWriter, r *http.Request) (object, bool) {
+	o := make(object)
+	if err := decode(r, &o); err != nil {
+		badRequest(w, err.Error())
+		return nil, false
+	}
+	return o, true
+}
+
+// decodeIDs decodes a request body of the form {"<key>": ["id", ...]}.
+func decodeIDs(w http.ResponseWriter, r *http.Request, key string) ([]string, bool) {
+	var body map[string][]string
+	if err := decode(r, &body); err != nil {
+		badRequest(w, err.Error())
+		return nil, false
+	}
+	ids, ok := body[key]
+	if !ok || len(ids) == 0 {
+		badRequest(w, fmt.Sprintf("Payload validation error: 'Missing required property: %s'.", key))
+		return nil, false
+	}
+	return ids, true
+}
+
+// toObject converts any value to its generic JSON representation.
+func toObject(v interface{}) object {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	o := make(object)
+	if err := json.Unmarshal(b, &o); err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// pick returns a copy of o containing only the given keys.
+func pick(o object, keys ...string) object {
+	p := make(object, len(keys))
+	for _, k := range keys {
+		if v, ok := o[k]; ok {
+			p[k] = v
+		}
+	}
+	return p
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,391 @@
+package managementtest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+	"gopkg.in/auth0.v5/management"
+)
+
+func newTestManagement(t *testing.T) (*Server, *management.Management) {
+	t.Helper()
+	s := NewServer()
+	m, err := s.Management()
+	if err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+	return s, m
+}
+
+func TestServerClient(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	c := &management.Client{Name: auth0.String("Test Client")}
+
+	t.Run("Create", func(t *testing.T) {
+		err := m.Client.Create(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.GetClientID() == "" || c.GetClientSecret() == "" {
+			t.Errorf("Expected client id and secret to be generated, but got %v", c)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		err := m.Client.Update(c.GetClientID(), &management.Client{
+			Description: auth0.String("Updated"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err = m.Client.Read(c.GetClientID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, c.GetName(), "Test Client")
+		expect.Expect(t, c.GetDescription(), "Updated")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := m.Client.Delete(c.GetClientID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = m.Client.Read(c.GetClientID())
+		if mErr, ok := err.(management.Error); !ok || mErr.Status() != http.StatusNotFound {
+			t.Errorf("Expected a 404 error, but got %v", err)
+		}
+	})
+}
+
+func TestServerPagination(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	for i := 0; i < 7; i++ {
+		err := m.Role.Create(&management.Role{Name: auth0.Stringf("role-%d", i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var names []string
+	var page int
+	for {
+		l, err := m.Role.List(management.Page(page), management.PerPage(3))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, l.Total, 7)
+		for _, r := range l.Roles {
+			names = append(names, r.GetName())
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+	expect.Expect(t, page, 2)
+	expect.Expect(t, len(names), 7)
+	expect.Expect(t, names[6], "role-6")
+
+	_, err := m.Role.List(management.PerPage(101))
+	if mErr, ok := err.(management.Error); !ok || mErr.Status() != http.StatusBadRequest {
+		t.Errorf("Expected a 400 error, but got %v", err)
+	}
+}
+
+func TestServerUser(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	err := m.Connection.Create(&management.Connection{
+		Name:     auth0.String("Username-Password-Authentication"),
+		Strategy: auth0.String("auth0"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u := &management.User{
+		Connection: auth0.String("Username-Password-Authentication"),
+		Email:      auth0.String("Chuck@ChuckNorris.com"),
+		Password:   auth0.String("Passwords hide their Chuck"),
+		AppMetadata: map[string]interface{}{
+			"plan": "free",
+		},
+	}
+	err = m.User.Create(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, u.GetEmail(), "chuck@chucknorris.com")
+
+	t.Run("Read", func(t *testing.T) {
+		u, err := m.User.Read(u.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, u.GetConnection(), "Username-Password-Authentication")
+		expect.Expect(t, u.Password, (*string)(nil))
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		err := m.User.Create(&management.User{
+			Connection: auth0.String("Username-Password-Authentication"),
+			Email:      auth0.String("chuck@chucknorris.com"),
+		})
+		if mErr, ok := err.(management.Error); !ok || mErr.Status() != http.StatusConflict {
+			t.Errorf("Expected a 409 error, but got %v", err)
+		}
+	})
+
+	t.Run("UpdateMetadata", func(t *testing.T) {
+		err := m.User.Update(u.GetID(), &management.User{
+			AppMetadata: map[string]interface{}{"vip": true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := m.User.Read(u.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, u.AppMetadata, map[string]interface{}{"plan": "free", "vip": true})
+	})
+
+	t.Run("Roles", func(t *testing.T) {
+		r := &management.Role{Name: auth0.String("admin")}
+		err := m.Role.Create(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = m.User.AssignRoles(u.GetID(), []*management.Role{r})
+		if err != nil {
+			t.Fatal(err)
+		}
+		l, err := m.Role.Users(r.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, len(l.Users), 1)
+		expect.Expect(t, l.Users[0].GetID(), u.GetID())
+	})
+
+	t.Run("ListByEmail", func(t *testing.T) {
+		us, err := m.User.ListByEmail("CHUCK@chucknorris.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, len(us), 1)
+	})
+}
+
+func TestServerOrganization(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	c := &management.Connection{
+		Name:     auth0.String("Username-Password-Authentication"),
+		Strategy: auth0.String("auth0"),
+	}
+	if err := m.Connection.Create(c); err != nil {
+		t.Fatal(err)
+	}
+	u := &management.User{Connection: c.Name, Email: auth0.String("jane@example.com")}
+	if err := m.User.Create(u); err != nil {
+		t.Fatal(err)
+	}
+
+	o := &management.Organization{Name: auth0.String("acme")}
+	if err := m.Organization.Create(o); err != nil {
+		t.Fatal(err)
+	}
+
+	err := m.Organization.AddConnection(o.GetID(), &management.OrganizationConnection{
+		ConnectionID: c.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	oc, err := m.Organization.Connection(o.GetID(), c.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, oc.GetConnection().GetName(), "Username-Password-Authentication")
+
+	if err := m.Organization.AddMembers(o.GetID(), []string{u.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	members, err := m.Organization.Members(o.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, len(members.Members), 1)
+	expect.Expect(t, members.Members[0].GetEmail(), "jane@example.com")
+
+	orgs, err := m.User.Organizations(u.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, len(orgs.Organizations), 1)
+
+	byName, err := m.Organization.ReadByName("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, byName.GetID(), o.GetID())
+}
+
+func TestServerAction(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	a := &management.Action{
+		Name: auth0.String("test-action"),
+		Code: auth0.String("exports.onExecutePostLogin = async (event, api) => {}"),
+		SupportedTriggers: []*management.ActionTrigger{
+			{ID: auth0.String(management.ActionTriggerPostLogin), Version: auth0.String("v2")},
+		},
+	}
+	if err := m.Action.Create(a); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := m.Action.Deploy(a.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, v.Deployed, true)
+	expect.Expect(t, v.Number, 1)
+
+	l, err := m.Action.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, l.Total, 1)
+	expect.Expect(t, l.Actions[0].AllChangesDeployed, true)
+
+	err = m.Action.UpdateBindings(management.ActionTriggerPostLogin, []*management.ActionBinding{
+		{
+			Ref: &management.ActionBindingReference{
+				Type:  auth0.String(management.ActionBindingReferenceByName),
+				Value: a.Name,
+			},
+			DisplayName: auth0.String("My Action"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bl, err := m.Action.Bindings(management.ActionTriggerPostLogin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, len(bl.Bindings), 1)
+	expect.Expect(t, bl.Bindings[0].GetAction().GetID(), a.GetID())
+}
+
+func TestServerLogs(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		s.AddLogs(&management.Log{
+			LogID:       auth0.Stringf("log-%d", i),
+			Type:        auth0.String("s"),
+			Description: auth0.Stringf("Log %d", i),
+		})
+	}
+
+	logs, err := m.Log.List(management.PerPage(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, len(logs), 2)
+
+	l, err := m.Log.Read("log-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, l.GetDescription(), "Log 2")
+	expect.Expect(t, l.TypeName(), "Success Login")
+}
+
+func TestServerJob(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	c := &management.Connection{
+		Name:     auth0.String("Username-Password-Authentication"),
+		Strategy: auth0.String("auth0"),
+	}
+	if err := m.Connection.Create(c); err != nil {
+		t.Fatal(err)
+	}
+
+	j := &management.Job{
+		ConnectionID: c.ID,
+		Users: []map[string]interface{}{
+			{"email": "alice@example.com"},
+			{"email": "bob@example.com"},
+			{"email": "alice@example.com"},
+		},
+	}
+	if err := m.Job.ImportUsers(j); err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, j.GetStatus(), "pending")
+
+	j, err := m.Job.Read(j.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, j.GetStatus(), "completed")
+
+	l, err := m.User.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, l.Total, 2)
+}
+
+func TestServerRateLimit(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	s.RateLimit(2)
+
+	_, err := m.Client.List()
+	if err != nil {
+		t.Errorf("Expected the rate limited request to be retried, but got %v", err)
+	}
+
+	s.RateLimit(1)
+	res, err := http.Get(s.URL + "/api/v2/clients")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	expect.Expect(t, res.StatusCode, http.StatusTooManyRequests)
+	expect.Expect(t, res.Header.Get("X-RateLimit-Remaining"), "0")
+}
+
+func TestServerNotFound(t *testing.T) {
+	s, m := newTestManagement(t)
+	defer s.Close()
+
+	err := m.Request("GET", m.URI("unsupported"), nil)
+	mErr, ok := err.(management.Error)
+	if !ok {
+		t.Fatalf("Expected a management error, but got %v", err)
+	}
+	expect.Expect(t, mErr.Status(), http.StatusNotFound)
+	expect.Expect(t, fmt.Sprint(mErr), "404 Not Found: Not Found")
+}
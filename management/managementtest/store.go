@@ -0,0 +1,191 @@
+package managementtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"strings"
+)
+
+// object is the generic representation of a resource held by the server. It
+// is the decoded JSON document of the resource.
+type object map[string]interface{}
+
+// clone returns a shallow copy of the object so that callers can safely
+// modify the top level properties of the result.
+func (o object) clone() object {
+	c := make(object, len(o))
+	for k, v := range o {
+		c[k] = v
+	}
+	return c
+}
+
+// string returns the string value of the property key, or "" if the property
+// is not set or is not a string.
+func (o object) string(key string) string {
+	s, _ := o[key].(string)
+	return s
+}
+
+// merge applies a PATCH payload onto the object. Properties listed in deep are
+// merged one level deep instead of being replaced, mimicking how Auth0 handles
+// metadata. A null value removes the property.
+func (o object) merge(patch object, deep ...string) {
+	isDeep := func(key string) bool {
+		for _, d := range deep {
+			if d == key {
+				return true
+			}
+		}
+		return false
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(o, k)
+			continue
+		}
+		if p, ok := v.(map[string]interface{}); ok && isDeep(k) {
+			m, _ := o[k].(map[string]interface{})
+			merged := make(map[string]interface{}, len(m)+len(p))
+			for mk, mv := range m {
+				merged[mk] = mv
+			}
+			for pk, pv := range p {
+				if pv == nil {
+					delete(merged, pk)
+					continue
+				}
+				merged[pk] = pv
+			}
+			o[k] = merged
+			continue
+		}
+		o[k] = v
+	}
+}
+
+// collection is an ordered set of objects identified by the value of their
+// id property.
+type collection struct {
+	idKey  string
+	prefix string
+	ids    []string
+	items  map[string]object
+}
+
+func newCollection(idKey, prefix string) *collection {
+	return &collection{
+		idKey:  idKey,
+		prefix: prefix,
+		items:  make(map[string]object),
+	}
+}
+
+// insert stores o, generating an id for it if one was not provided.
+func (c *collection) insert(o object) object {
+	id := o.string(c.idKey)
+	if id == "" {
+		id = c.prefix + randomID(16)
+		o[c.idKey] = id
+	}
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = o
+	return o
+}
+
+func (c *collection) get(id string) (object, bool) {
+	o, ok := c.items[id]
+	return o, ok
+}
+
+func (c *collection) remove(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+	delete(c.items, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// find returns the first object for which fn returns true.
+func (c *collection) find(fn func(o object) bool) (object, bool) {
+	for _, id := range c.ids {
+		if o := c.items[id]; fn(o) {
+			return o, true
+		}
+	}
+	return nil, false
+}
+
+// filter returns the objects for which fn returns true in insertion order. A
+// nil fn returns every object.
+func (c *collection) filter(fn func(o object) bool) []object {
+	var l []object
+	for _, id := range c.ids {
+		if o := c.items[id]; fn == nil || fn(o) {
+			l = append(l, o)
+		}
+	}
+	return l
+}
+
+// set is a set of string identifiers, used to store relationships between
+// resources such as role members.
+type set map[string]struct{}
+
+func (s set) add(ids ...string) {
+	for _, id := range ids {
+		s[id] = struct{}{}
+	}
+}
+
+func (s set) remove(ids ...string) {
+	for _, id := range ids {
+		delete(s, id)
+	}
+}
+
+func (s set) sorted() []string {
+	l := make([]string, 0, len(s))
+	for id := range s {
+		l = append(l, id)
+	}
+	sort.Strings(l)
+	return l
+}
+
+// relation maps a resource id to a set of related resource ids.
+type relation map[string]set
+
+func (r relation) of(id string) set {
+	s, ok := r[id]
+	if !ok {
+		s = make(set)
+		r[id] = s
+	}
+	return s
+}
+
+// unlink removes id from every set in the relation.
+func (r relation) unlink(id string) {
+	delete(r, id)
+	for _, s := range r {
+		s.remove(id)
+	}
+}
+
+func randomID(n int) string {
+	b := make([]byte, n/2)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return strings.ToLower(hex.EncodeToString(b))
+}
@@ -0,0 +1,163 @@
+package managementtest
+
+import (
+	"net/http"
+	"strings"
+)
+
+func (s *Server) handleUsers(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeList(w, r, "users", s.users.filter(nil), false)
+
+	case len(path) == 0 && r.Method == http.MethodPost:
+		o, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if status, code, message := s.createUser(o); status != http.StatusCreated {
+			writeError(w, status, code, message)
+			return
+		}
+		writeObject(w, r, http.StatusCreated, o)
+
+	case len(path) == 1:
+		u, ok := s.users.get(path[0])
+		if !ok {
+			writeError(w, http.StatusNotFound, "inexistent_user", "The user does not exist.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeObject(w, r, http.StatusOK, u)
+		case http.MethodPatch:
+			o, ok := decodeObject(w, r)
+			if !ok {
+				return
+			}
+			delete(o, "user_id")
+			delete(o, "password")
+			delete(o, "verify_email")
+			u.merge(o, "app_metadata", "user_metadata")
+			u["updated_at"] = now()
+			writeObject(w, r, http.StatusOK, u)
+		case http.MethodDelete:
+			s.users.remove(path[0])
+			s.userRoles.unlink(path[0])
+			s.members.unlink(path[0])
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	case len(path) == 2 && path[1] == "roles":
+		if _, ok := s.users.get(path[0]); !ok {
+			writeError(w, http.StatusNotFound, "inexistent_user", "The user does not exist.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			var roles []object
+			for _, id := range s.userRoles.of(path[0]).sorted() {
+				if role, ok := s.roles.get(id); ok {
+					roles = append(roles, role)
+				}
+			}
+			writeList(w, r, "roles", roles, false)
+		case http.MethodPost, http.MethodDelete:
+			ids, ok := decodeIDs(w, r, "roles")
+			if !ok {
+				return
+			}
+			if r.Method == http.MethodPost {
+				for _, id := range ids {
+					if _, ok := s.roles.get(id); !ok {
+						notFound(w, "The role does not exist.")
+						return
+					}
+				}
+				s.userRoles.of(path[0]).add(ids...)
+			} else {
+				s.userRoles.of(path[0]).remove(ids...)
+			}
+			noContent(w)
+		default:
+			notFound(w, "Not Found")
+		}
+
+	case len(path) == 2 && path[1] == "organizations" && r.Method == http.MethodGet:
+		var orgs []object
+		for _, o := range s.organizations.filter(nil) {
+			if _, ok := s.members.of(o.string("id"))[path[0]]; ok {
+				orgs = append(orgs, o)
+			}
+		}
+		writeList(w, r, "organizations", orgs, false)
+
+	default:
+		notFound(w, "Not Found")
+	}
+}
+
+func (s *Server) handleUsersByEmail(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) != 0 || r.Method != http.MethodGet {
+		notFound(w, "Not Found")
+		return
+	}
+	email := strings.ToLower(r.URL.Query().Get("email"))
+	users := make([]object, 0)
+	for _, u := range s.users.filter(func(o object) bool {
+		return strings.ToLower(o.string("email")) == email
+	}) {
+		users = append(users, view(r, u))
+	}
+	writeJSON(w, http.StatusOK, users)
+}
+
+// createUser validates and stores a new user. On failure it returns the
+// status, error code and message to respond with.
+func (s *Server) createUser(o object) (status int, code, message string) {
+	connection := o.string("connection")
+	if connection == "" {
+		return http.StatusBadRequest, "invalid_body", "Payload validation error: 'Missing required property: connection'."
+	}
+	if _, ok := s.connectionByName(connection); !ok {
+		return http.StatusBadRequest, "inexistent_connection", "The connection does not exist."
+	}
+	if email := strings.ToLower(o.string("email")); email != "" {
+		o["email"] = email
+		if _, exists := s.users.find(func(u object) bool {
+			return u.string("connection") == connection && u.string("email") == email
+		}); exists {
+			return http.StatusConflict, "auth0_idp_error", "The user already exists."
+		}
+	}
+	if id := o.string("user_id"); id != "" && !strings.Contains(id, "|") {
+		o["user_id"] = "auth0|" + id
+	}
+	if id := o.string("user_id"); id != "" {
+		if _, exists := s.users.get(id); exists {
+			return http.StatusConflict, "auth0_idp_error", "The user already exists."
+		}
+	}
+
+	delete(o, "password")
+	delete(o, "verify_email")
+	if _, ok := o["email_verified"]; !ok {
+		o["email_verified"] = false
+	}
+	o["created_at"] = now()
+	o["updated_at"] = o["created_at"]
+	o["logins_count"] = 0
+
+	s.users.insert(o)
+	o["identities"] = []interface{}{
+		map[string]interface{}{
+			"connection": connection,
+			"user_id":    strings.TrimPrefix(o.string("user_id"), "auth0|"),
+			"provider":   "auth0",
+			"isSocial":   false,
+		},
+	}
+	return http.StatusCreated, "", ""
+}
@@ -16,6 +16,9 @@ type Organization struct {
 	// Metadata associated with the organization, in the form of an object with
 	// string values (max 255 chars). Maximum of 10 metadata properties allowed.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type OrganizationBranding struct {
@@ -24,6 +27,9 @@ type OrganizationBranding struct {
 
 	// Color scheme used to customize the login pages
 	Colors map[string]interface{} `json:"colors,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type OrganizationMember struct {
@@ -134,26 +140,108 @@ type OrganizationMemberRoleList struct {
 	Roles []OrganizationMemberRole `json:"roles"`
 }
 
+// OrganizationMemberRoleIterator iterates over organization member roles,
+// fetching further pages as needed.
+type OrganizationMemberRoleIterator struct {
+	it *iterator
+}
+
+// Next returns the next organization member role. It returns Done when there
+// are no more organization member roles.
+func (i *OrganizationMemberRoleIterator) Next() (*OrganizationMemberRole, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	o := v.(OrganizationMemberRole)
+	return &o, nil
+}
+
 type OrganizationInvitationList struct {
 	List
 	OrganizationInvitations []*OrganizationInvitation `json:"invitations"`
 }
 
+// OrganizationInvitationIterator iterates over organization invitations,
+// fetching further pages as needed.
+type OrganizationInvitationIterator struct {
+	it *iterator
+}
+
+// Next returns the next organization invitation. It returns Done when there are
+// no more organization invitations.
+func (i *OrganizationInvitationIterator) Next() (*OrganizationInvitation, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*OrganizationInvitation), nil
+}
+
 type OrganizationConnectionList struct {
 	List
 	OrganizationConnections []*OrganizationConnection `json:"enabled_connections"`
 }
 
+// OrganizationConnectionIterator iterates over organization connections,
+// fetching further pages as needed.
+type OrganizationConnectionIterator struct {
+	it *iterator
+}
+
+// Next returns the next organization connection. It returns Done when there are
+// no more organization connections.
+func (i *OrganizationConnectionIterator) Next() (*OrganizationConnection, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*OrganizationConnection), nil
+}
+
 type OrganizationMemberList struct {
 	List
 	Members []OrganizationMember `json:"members"`
 }
 
+// OrganizationMemberIterator iterates over organization members, fetching
+// further pages as needed.
+type OrganizationMemberIterator struct {
+	it *iterator
+}
+
+// Next returns the next organization member. It returns Done when there are no
+// more organization members.
+func (i *OrganizationMemberIterator) Next() (*OrganizationMember, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	o := v.(OrganizationMember)
+	return &o, nil
+}
+
 type OrganizationList struct {
 	List
 	Organizations []*Organization `json:"organizations"`
 }
 
+// OrganizationIterator iterates over organizations, fetching further pages as
+// needed.
+type OrganizationIterator struct {
+	it *iterator
+}
+
+// Next returns the next organization. It returns Done when there are no more
+// organizations.
+func (i *OrganizationIterator) Next() (*Organization, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Organization), nil
+}
+
 type OrganizationManager struct {
 	*Management
 }
@@ -166,15 +254,23 @@ func newOrganizationManager(m *Management) *OrganizationManager {
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_organizations
 func (m *OrganizationManager) List(opts ...RequestOption) (o *OrganizationList, err error) {
-	err = m.Request("GET", m.URI("organizations"), &o, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("organizations"), &o, applyListDefaults(withOperation("Organization.List", opts)))
 	return
 }
 
+// Iterate returns an iterator over all organizations, fetching further pages as
+// the iteration advances.
+func (m *OrganizationManager) Iterate(opts ...RequestOption) *OrganizationIterator {
+	return &OrganizationIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
 // Create an Organization
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_organizations
 func (m *OrganizationManager) Create(o *Organization, opts ...RequestOption) (err error) {
-	err = m.Request("POST", m.URI("organizations"), &o, opts...)
+	err = m.Request("POST", m.URI("organizations"), &o, withOperation("Organization.Create", opts)...)
 	return
 }
 
@@ -182,7 +278,7 @@ func (m *OrganizationManager) Create(o *Organization, opts ...RequestOption) (er
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_organizations_by_id
 func (m *OrganizationManager) Read(id string, opts ...RequestOption) (o *Organization, err error) {
-	err = m.Request("GET", m.URI("organizations", id), &o, opts...)
+	err = m.Request("GET", m.URI("organizations", id), &o, withOperation("Organization.Read", opts)...)
 	return
 }
 
@@ -190,7 +286,7 @@ func (m *OrganizationManager) Read(id string, opts ...RequestOption) (o *Organiz
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/delete_organizations_by_id
 func (m *OrganizationManager) Delete(id string, opts ...RequestOption) (err error) {
-	err = m.Request("DELETE", m.URI("organizations", id), nil, opts...)
+	err = m.Request("DELETE", m.URI("organizations", id), nil, withOperation("Organization.Delete", opts)...)
 	return
 }
 
@@ -198,7 +294,7 @@ func (m *OrganizationManager) Delete(id string, opts ...RequestOption) (err erro
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/patch_organizations_by_id
 func (m *OrganizationManager) Update(id string, o *Organization, opts ...RequestOption) (err error) {
-	err = m.Request("PATCH", m.URI("organizations", id), &o, opts...)
+	err = m.Request("PATCH", m.URI("organizations", id), &o, withOperation("Organization.Update", opts)...)
 	return
 }
 
@@ -206,7 +302,7 @@ func (m *OrganizationManager) Update(id string, o *Organization, opts ...Request
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_name_by_name
 func (m *OrganizationManager) ReadByName(name string, opts ...RequestOption) (o *Organization, err error) {
-	err = m.Request("GET", m.URI("organizations", "name", name), &o, opts...)
+	err = m.Request("GET", m.URI("organizations", "name", name), &o, withOperation("Organization.ReadByName", opts)...)
 	return
 }
 
@@ -214,15 +310,23 @@ func (m *OrganizationManager) ReadByName(name string, opts ...RequestOption) (o
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_enabled_connections
 func (m *OrganizationManager) Connections(id string, opts ...RequestOption) (c *OrganizationConnectionList, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "enabled_connections"), &c, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("organizations", id, "enabled_connections"), &c, applyListDefaults(withOperation("Organization.Connections", opts)))
 	return
 }
 
+// IterateConnections returns an iterator over the connections enabled for an
+// organization, fetching further pages as the iteration advances.
+func (m *OrganizationManager) IterateConnections(id string, opts ...RequestOption) *OrganizationConnectionIterator {
+	return &OrganizationConnectionIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Connections(id, opts...)
+	})}
+}
+
 // Add connections to an organization
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_enabled_connections
 func (m *OrganizationManager) AddConnection(id string, c *OrganizationConnection, opts ...RequestOption) (err error) {
-	err = m.Request("POST", m.URI("organizations", id, "enabled_connections"), &c, opts...)
+	err = m.Request("POST", m.URI("organizations", id, "enabled_connections"), &c, withOperation("Organization.AddConnection", opts)...)
 	return
 }
 
@@ -230,7 +334,7 @@ func (m *OrganizationManager) AddConnection(id string, c *OrganizationConnection
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_enabled_connections_by_connectionId
 func (m *OrganizationManager) Connection(id string, connectionID string, opts ...RequestOption) (c *OrganizationConnection, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "enabled_connections", connectionID), &c, opts...)
+	err = m.Request("GET", m.URI("organizations", id, "enabled_connections", connectionID), &c, withOperation("Organization.Connection", opts)...)
 	return
 }
 
@@ -238,7 +342,7 @@ func (m *OrganizationManager) Connection(id string, connectionID string, opts ..
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/delete_enabled_connections_by_connectionId
 func (m *OrganizationManager) DeleteConnection(id string, connectionID string, opts ...RequestOption) (err error) {
-	err = m.Request("DELETE", m.URI("organizations", id, "enabled_connections", connectionID), nil, opts...)
+	err = m.Request("DELETE", m.URI("organizations", id, "enabled_connections", connectionID), nil, withOperation("Organization.DeleteConnection", opts)...)
 	return
 }
 
@@ -246,7 +350,7 @@ func (m *OrganizationManager) DeleteConnection(id string, connectionID string, o
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/patch_enabled_connections_by_connectionId
 func (m *OrganizationManager) UpdateConnection(id string, connectionID string, c *OrganizationConnection, opts ...RequestOption) (err error) {
-	err = m.Request("PATCH", m.URI("organizations", id, "enabled_connections", connectionID), &c, opts...)
+	err = m.Request("PATCH", m.URI("organizations", id, "enabled_connections", connectionID), &c, withOperation("Organization.UpdateConnection", opts)...)
 	return
 }
 
@@ -254,15 +358,23 @@ func (m *OrganizationManager) UpdateConnection(id string, connectionID string, c
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_invitations
 func (m *OrganizationManager) Invitations(id string, opts ...RequestOption) (i *OrganizationInvitationList, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "invitations"), &i, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("organizations", id, "invitations"), &i, applyListDefaults(withOperation("Organization.Invitations", opts)))
 	return
 }
 
+// IterateInvitations returns an iterator over the invitations to an
+// organization, fetching further pages as the iteration advances.
+func (m *OrganizationManager) IterateInvitations(id string, opts ...RequestOption) *OrganizationInvitationIterator {
+	return &OrganizationInvitationIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Invitations(id, opts...)
+	})}
+}
+
 // Create invitations to organization
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_invitations
 func (m *OrganizationManager) CreateInvitation(id string, i *OrganizationInvitation, opts ...RequestOption) (err error) {
-	err = m.Request("POST", m.URI("organizations", id, "invitations"), &i, opts...)
+	err = m.Request("POST", m.URI("organizations", id, "invitations"), &i, withOperation("Organization.CreateInvitation", opts)...)
 	return
 }
 
@@ -270,7 +382,7 @@ func (m *OrganizationManager) CreateInvitation(id string, i *OrganizationInvitat
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_invitations_by_invitation_id
 func (m *OrganizationManager) Invitation(id string, invitationID string, opts ...RequestOption) (i *OrganizationInvitation, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "invitations", invitationID), &i, opts...)
+	err = m.Request("GET", m.URI("organizations", id, "invitations", invitationID), &i, withOperation("Organization.Invitation", opts)...)
 	return
 }
 
@@ -278,7 +390,7 @@ func (m *OrganizationManager) Invitation(id string, invitationID string, opts ..
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/delete_invitations_by_invitation_id
 func (m *OrganizationManager) DeleteInvitation(id string, invitationID string, opts ...RequestOption) (err error) {
-	err = m.Request("DELETE", m.URI("organizations", id, "invitations", invitationID), nil, opts...)
+	err = m.Request("DELETE", m.URI("organizations", id, "invitations", invitationID), nil, withOperation("Organization.DeleteInvitation", opts)...)
 	return
 }
 
@@ -286,10 +398,24 @@ func (m *OrganizationManager) DeleteInvitation(id string, invitationID string, o
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_members
 func (m *OrganizationManager) Members(id string, opts ...RequestOption) (o *OrganizationMemberList, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "members"), &o, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("organizations", id, "members"), &o, applyListDefaults(withOperation("Organization.Members", opts)))
 	return
 }
 
+// IterateMembers returns an iterator over the members of an organization,
+// fetching further pages as the iteration advances.
+//
+// Pages are requested using checkpoint pagination, which unlike the page and
+// per_page parameters used by Members is not limited to the first 1000
+// members. The page size can be configured using Take.
+func (m *OrganizationManager) IterateMembers(id string, opts ...RequestOption) *OrganizationMemberIterator {
+	return &OrganizationMemberIterator{newCheckpointIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		var l *OrganizationMemberList
+		err := m.Request("GET", m.URI("organizations", id, "members"), &l, withOperation("Organization.IterateMembers", opts)...)
+		return l, err
+	}, checkpointNext)}
+}
+
 // Add members to an organization
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_members
@@ -299,7 +425,7 @@ func (m *OrganizationManager) AddMembers(id string, memberIDs []string, opts ...
 	}{
 		Members: memberIDs,
 	}
-	err = m.Request("POST", m.URI("organizations", id, "members"), &body, opts...)
+	err = m.Request("POST", m.URI("organizations", id, "members"), &body, withOperation("Organization.AddMembers", opts)...)
 	return
 }
 
@@ -312,7 +438,7 @@ func (m *OrganizationManager) DeleteMember(id string, memberIDs []string, opts .
 	}{
 		Members: memberIDs,
 	}
-	err = m.Request("DELETE", m.URI("organizations", id, "members"), &body, opts...)
+	err = m.Request("DELETE", m.URI("organizations", id, "members"), &body, withOperation("Organization.DeleteMember", opts)...)
 	return
 }
 
@@ -320,10 +446,18 @@ func (m *OrganizationManager) DeleteMember(id string, memberIDs []string, opts .
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/get_organization_member_roles
 func (m *OrganizationManager) MemberRoles(id string, memberID string, opts ...RequestOption) (r *OrganizationMemberRoleList, err error) {
-	err = m.Request("GET", m.URI("organizations", id, "members", memberID, "roles"), &r, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("organizations", id, "members", memberID, "roles"), &r, applyListDefaults(withOperation("Organization.MemberRoles", opts)))
 	return
 }
 
+// IterateMemberRoles returns an iterator over the roles assigned to an
+// organization member, fetching further pages as the iteration advances.
+func (m *OrganizationManager) IterateMemberRoles(id string, memberID string, opts ...RequestOption) *OrganizationMemberRoleIterator {
+	return &OrganizationMemberRoleIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.MemberRoles(id, memberID, opts...)
+	})}
+}
+
 // Assign one or more roles to a given user that will be applied in the context of the provided organization
 //
 // See: https://auth0.com/docs/api/management/v2/#!/Organizations/post_organization_member_roles
@@ -333,7 +467,7 @@ func (m *OrganizationManager) AssignMemberRoles(id string, memberID string, role
 	}{
 		Roles: roles,
 	}
-	err = m.Request("POST", m.URI("organizations", id, "members", memberID, "roles"), &body, opts...)
+	err = m.Request("POST", m.URI("organizations", id, "members", memberID, "roles"), &body, withOperation("Organization.AssignMemberRoles", opts)...)
 	return
 }
 
@@ -346,6 +480,6 @@ func (m *OrganizationManager) DeleteMemberRoles(id string, memberID string, role
 	}{
 		Roles: roles,
 	}
-	err = m.Request("DELETE", m.URI("organizations", id, "members", memberID, "roles"), &body, opts...)
+	err = m.Request("DELETE", m.URI("organizations", id, "members", memberID, "roles"), &body, withOperation("Organization.DeleteMemberRoles", opts)...)
 	return
 }
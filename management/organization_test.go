@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 	"testing"
-	"time"
 
 	"gopkg.in/auth0.v5"
 )
@@ -12,7 +11,7 @@ import (
 func TestOrganization(t *testing.T) {
 	var err error
 
-	ts := time.Now().Format("20060102150405")
+	ts := testTime().Format("20060102150405")
 
 	client := &Client{
 		Name:              auth0.Stringf("testclient%v", ts),
@@ -0,0 +1,156 @@
+package management
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var extraType = reflect.TypeOf(map[string]interface{}(nil))
+
+// Patch returns a value of the same type as old and new, such as *Client,
+// holding only the properties which differ between them. It can be passed to
+// the Update method of the corresponding manager to send a minimal PATCH
+// payload.
+//
+//     p, err := management.Patch(old, new)
+//     if err != nil {
+//         // handle err
+//     }
+//     err = m.Client.Update(id, p.(*management.Client))
+//
+// Nested objects, such as connection options or tenant flags, are compared
+// deeply and sent whole when they differ, as the Auth0 Management API
+// replaces some of them entirely. Properties set in old but not in new are
+// sent through the Extra field to be removed, as an empty array for lists and
+// as null otherwise. Properties unknown to the SDK, held by Extra, are
+// compared as well.
+//
+// Types without an Extra field, such as Grant, cannot hold removed
+// properties, which are left out of the returned value. Their removal needs
+// to be sent separately, for instance using the Null request option.
+//
+// If old is nil, the returned value is a copy of new.
+func Patch(old, new interface{}) (interface{}, error) {
+	o, n := reflect.ValueOf(old), reflect.ValueOf(new)
+	if n.Kind() != reflect.Ptr || n.IsNil() || n.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("management: cannot patch %T, a pointer to a struct is required", new)
+	}
+	if old == nil {
+		o = reflect.Zero(n.Type())
+	}
+	if o.Type() != n.Type() {
+		return nil, fmt.Errorf("management: cannot patch %T with %T", old, new)
+	}
+
+	p := reflect.New(n.Type().Elem())
+	if o.IsNil() {
+		p.Elem().Set(n.Elem())
+	} else {
+		patch(o.Elem(), n.Elem(), p.Elem())
+	}
+	return p.Interface(), nil
+}
+
+func patch(o, n, p reflect.Value) {
+	t := n.Type()
+
+	var extra map[string]interface{}
+	setExtra := func(k string, v interface{}) {
+		if extra == nil {
+			extra = make(map[string]interface{})
+		}
+		extra[k] = v
+	}
+
+	var extraField reflect.Value
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		of, nf := o.Field(i), n.Field(i)
+
+		if f.Name == "Extra" && f.Type == extraType {
+			extraField = p.Field(i)
+			oe := of.Interface().(map[string]interface{})
+			ne := nf.Interface().(map[string]interface{})
+			for k, v := range ne {
+				if ov, ok := oe[k]; !ok || !reflect.DeepEqual(ov, v) {
+					setExtra(k, v)
+				}
+			}
+			for k := range oe {
+				if _, ok := ne[k]; !ok {
+					setExtra(k, nil)
+				}
+			}
+			continue
+		}
+
+		if reflect.DeepEqual(of.Interface(), nf.Interface()) {
+			continue
+		}
+
+		name, omitEmpty := jsonField(f)
+		if omitEmpty && isEmptyValue(nf) {
+			// The value would be omitted when marshaling, so it is sent
+			// through Extra instead, as null or as an empty array for
+			// slices.
+			if name == "" {
+				continue
+			}
+			if nf.Kind() == reflect.Slice {
+				setExtra(name, reflect.MakeSlice(nf.Type(), 0, 0).Interface())
+			} else {
+				setExtra(name, nf.Interface())
+			}
+			continue
+		}
+		p.Field(i).Set(nf)
+	}
+
+	if extra != nil && extraField.IsValid() {
+		extraField.Set(reflect.ValueOf(extra))
+	}
+}
+
+// jsonField returns the JSON property name of f, or an empty string if f is
+// not marshaled by encoding/json, and whether it is omitted when empty.
+func jsonField(f reflect.StructField) (name string, omitEmpty bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = f.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty
+}
+
+// isEmptyValue reports whether v is omitted by encoding/json when tagged with
+// omitempty.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package management
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestPatch(t *testing.T) {
+
+	for name, test := range map[string]struct {
+		old, new interface{}
+		expected string
+	}{
+		"Unchanged": {
+			&Client{ClientID: auth0.String("123"), Name: auth0.String("app")},
+			&Client{ClientID: auth0.String("123"), Name: auth0.String("app")},
+			`{}`,
+		},
+		"Changed": {
+			&Client{ClientID: auth0.String("123"), Name: auth0.String("app"), Callbacks: []interface{}{"https://a"}},
+			&Client{ClientID: auth0.String("123"), Name: auth0.String("renamed"), Callbacks: []interface{}{"https://a", "https://b"}},
+			`{"name":"renamed","callbacks":["https://a","https://b"]}`,
+		},
+		"Removed": {
+			&Client{Name: auth0.String("app"), Description: auth0.String("description")},
+			&Client{Name: auth0.String("app")},
+			`{"description":null}`,
+		},
+		"RemovedList": {
+			&Client{Name: auth0.String("app"), Callbacks: []interface{}{"https://a"}},
+			&Client{Name: auth0.String("app")},
+			`{"callbacks":[]}`,
+		},
+		"RemovedWithoutExtra": {
+			&Grant{Audience: auth0.String("https://api"), Scope: []interface{}{"read"}},
+			&Grant{},
+			`{"user_id":null}`,
+		},
+		"Nested": {
+			&Tenant{Flags: &TenantFlags{EnableAPIsSection: auth0.Bool(true), EnablePipeline2: auth0.Bool(true)}},
+			&Tenant{Flags: &TenantFlags{EnableAPIsSection: auth0.Bool(false), EnablePipeline2: auth0.Bool(true)}},
+			`{"flags":{"enable_apis_section":false,"enable_pipeline2":true}}`,
+		},
+		"ConnectionOptions": {
+			&Connection{
+				Name:     auth0.String("db"),
+				Strategy: auth0.String("auth0"),
+				Options:  &ConnectionOptions{BruteForceProtection: auth0.Bool(true), PasswordPolicy: auth0.String("good")},
+			},
+			&Connection{
+				Name:     auth0.String("db"),
+				Strategy: auth0.String("auth0"),
+				Options:  &ConnectionOptions{BruteForceProtection: auth0.Bool(true), PasswordPolicy: auth0.String("fair")},
+			},
+			`{"options":{"passwordPolicy":"fair","brute_force_protection":true}}`,
+		},
+		"Extra": {
+			&Role{Name: auth0.String("admin"), Extra: map[string]interface{}{"a": 1.0, "b": true}},
+			&Role{Name: auth0.String("admin"), Extra: map[string]interface{}{"a": 2.0}},
+			`{"a":2,"b":null}`,
+		},
+		"Create": {
+			nil,
+			&Role{Name: auth0.String("admin")},
+			`{"name":"admin"}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			p, err := Patch(test.old, test.new)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expect.Expect(t, string(b), test.expected)
+		})
+	}
+
+	t.Run("InvalidTypes", func(t *testing.T) {
+		if _, err := Patch(&Client{}, &Role{}); err == nil {
+			t.Error("Expected an error patching values of different types")
+		}
+		if _, err := Patch(Client{}, Client{}); err == nil {
+			t.Error("Expected an error patching values which are not pointers")
+		}
+	})
+}
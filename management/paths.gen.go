@@ -0,0 +1,92 @@
+// Code generated by gen-paths; DO NOT EDIT.
+
+package management
+
+// pathSegments are the static path segments of the Management API endpoints
+// used by the managers. Any other segment is a resource identifier.
+var pathSegments = map[string]bool{
+	"actions":                     true,
+	"active-users":                true,
+	"anomaly":                     true,
+	"api":                         true,
+	"bindings":                    true,
+	"blacklists":                  true,
+	"blocks":                      true,
+	"branding":                    true,
+	"client-grants":               true,
+	"clients":                     true,
+	"connections":                 true,
+	"custom-domains":              true,
+	"custom-text":                 true,
+	"daily":                       true,
+	"deploy":                      true,
+	"duo":                         true,
+	"email":                       true,
+	"email-templates":             true,
+	"email-verification":          true,
+	"emails":                      true,
+	"enabled_connections":         true,
+	"enrollments":                 true,
+	"errors":                      true,
+	"executions":                  true,
+	"factors":                     true,
+	"grants":                      true,
+	"guardian":                    true,
+	"hooks":                       true,
+	"identities":                  true,
+	"invalidate-remember-browser": true,
+	"invitations":                 true,
+	"ips":                         true,
+	"jobs":                        true,
+	"keys":                        true,
+	"log-streams":                 true,
+	"logs":                        true,
+	"members":                     true,
+	"message-types":               true,
+	"multifactor":                 true,
+	"name":                        true,
+	"organizations":               true,
+	"otp":                         true,
+	"password-change":             true,
+	"permissions":                 true,
+	"phone":                       true,
+	"policies":                    true,
+	"prompts":                     true,
+	"provider":                    true,
+	"providers":                   true,
+	"push-notification":           true,
+	"recovery-code-regeneration":  true,
+	"resource-servers":            true,
+	"revoke":                      true,
+	"roles":                       true,
+	"rotate":                      true,
+	"rotate-secret":               true,
+	"rules":                       true,
+	"rules-configs":               true,
+	"secrets":                     true,
+	"selected-provider":           true,
+	"settings":                    true,
+	"signing":                     true,
+	"sms":                         true,
+	"sns":                         true,
+	"stats":                       true,
+	"templates":                   true,
+	"tenants":                     true,
+	"test":                        true,
+	"ticket":                      true,
+	"tickets":                     true,
+	"tokens":                      true,
+	"triggers":                    true,
+	"twilio":                      true,
+	"universal-login":             true,
+	"user-blocks":                 true,
+	"users":                       true,
+	"users-by-email":              true,
+	"users-exports":               true,
+	"users-imports":               true,
+	"v2":                          true,
+	"verification-email":          true,
+	"verify":                      true,
+	"versions":                    true,
+	"webauthn-roaming":            true,
+}
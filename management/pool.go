@@ -0,0 +1,166 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// TenantDefinition describes an Auth0 tenant managed through a Pool.
+type TenantDefinition struct {
+	// The name identifying the tenant in the pool, such as "eu-production".
+	Name string `json:"name"`
+
+	// The domain of the tenant, such as "example.eu.auth0.com".
+	Domain string `json:"domain"`
+
+	// The client credentials used to authenticate, if any. The secret can be
+	// read from the environment variable named by ClientSecretEnv instead,
+	// when the management client is created.
+	ClientID        string `json:"client_id,omitempty"`
+	ClientSecret    string `json:"client_secret,omitempty"`
+	ClientSecretEnv string `json:"client_secret_env,omitempty"`
+
+	// Options configures the management client of the tenant, for example
+	// using WithPrivateKeyJWT or WithStaticToken to authenticate. They are
+	// applied after the options of the pool.
+	Options []ManagementOption `json:"-"`
+}
+
+// String returns a string representation of t, in which the client secret is
+// redacted.
+func (t TenantDefinition) String() string {
+	if t.ClientSecret != "" {
+		t.ClientSecret = "[REDACTED]"
+	}
+	return Stringify(t)
+}
+
+// LoadTenantDefinitions decodes tenant definitions from a JSON array, such as
+// a configuration file.
+//
+//     [
+//         {"name": "eu-production", "domain": "example.eu.auth0.com",
+//          "client_id": "...", "client_secret_env": "EU_PRODUCTION_SECRET"}
+//     ]
+func LoadTenantDefinitions(r io.Reader) ([]TenantDefinition, error) {
+	var defs []TenantDefinition
+	if err := json.NewDecoder(r).Decode(&defs); err != nil {
+		return nil, fmt.Errorf("decoding tenant definitions failed: %w", err)
+	}
+	return defs, nil
+}
+
+// Pool holds the management clients of several Auth0 tenants, which are
+// created when first used.
+//
+// Management clients share the HTTP client configured for the pool using
+// WithClient, http.DefaultClient by default, while each tenant has its own
+// token source and rate limit tracking.
+type Pool struct {
+	names   []string
+	defs    map[string]TenantDefinition
+	options []ManagementOption
+
+	mu      sync.Mutex
+	clients map[string]*Management
+}
+
+// NewPool returns a pool of the tenants, whose management clients are
+// configured using options followed by those of the tenant definition.
+func NewPool(tenants []TenantDefinition, options ...ManagementOption) (*Pool, error) {
+	p := &Pool{
+		defs:    make(map[string]TenantDefinition, len(tenants)),
+		options: options,
+		clients: make(map[string]*Management),
+	}
+	for _, t := range tenants {
+		if t.Name == "" || t.Domain == "" {
+			return nil, fmt.Errorf("tenant definition %q requires a name and a domain", t.Name)
+		}
+		if _, ok := p.defs[t.Name]; ok {
+			return nil, fmt.Errorf("tenant %q is defined more than once", t.Name)
+		}
+		p.names = append(p.names, t.Name)
+		p.defs[t.Name] = t
+	}
+	return p, nil
+}
+
+// Tenants returns the names of the tenants, in the order they were defined.
+func (p *Pool) Tenants() []string {
+	return append([]string(nil), p.names...)
+}
+
+// Get returns the management client of the named tenant, creating it if
+// needed.
+func (p *Pool) Get(name string) (*Management, error) {
+	def, ok := p.defs[name]
+	if !ok {
+		return nil, fmt.Errorf("tenant %q is not defined", name)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if m, ok := p.clients[name]; ok {
+		return m, nil
+	}
+
+	options := append([]ManagementOption{WithRateLimiter()}, p.options...)
+	if def.ClientID != "" {
+		secret := def.ClientSecret
+		if def.ClientSecretEnv != "" {
+			secret = os.Getenv(def.ClientSecretEnv)
+			if secret == "" {
+				return nil, fmt.Errorf("tenant %q: environment variable %s is not set", name, def.ClientSecretEnv)
+			}
+		}
+		options = append(options, WithClientCredentials(def.ClientID, secret))
+	}
+	options = append(options, def.Options...)
+
+	m, err := New(def.Domain, options...)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %q: %w", name, err)
+	}
+	p.clients[name] = m
+	return m, nil
+}
+
+// PoolResult is the result of a function run on a tenant by Pool.FanOut.
+type PoolResult struct {
+	Tenant string
+	Value  interface{}
+	Err    error
+}
+
+// FanOut runs fn concurrently on every tenant of the pool, and returns the
+// results in the order the tenants were defined. Tenants whose management
+// client cannot be created have an error result.
+//
+//     results := pool.FanOut(ctx, func(ctx context.Context, tenant string, m *management.Management) (interface{}, error) {
+//         return m.Client.ListContext(ctx)
+//     })
+func (p *Pool) FanOut(ctx context.Context, fn func(ctx context.Context, tenant string, m *Management) (interface{}, error)) []PoolResult {
+	results := make([]PoolResult, len(p.names))
+	var wg sync.WaitGroup
+	for i, name := range p.names {
+		wg.Add(1)
+		go func(r *PoolResult, name string) {
+			defer wg.Done()
+			r.Tenant = name
+			m, err := p.Get(name)
+			if err != nil {
+				r.Err = err
+				return
+			}
+			r.Value, r.Err = fn(ctx, name, m)
+		}(&results[i], name)
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,116 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"gopkg.in/auth0.v5/internal/client"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestPool(t *testing.T) {
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"` + r.Host + `","token_type":"Bearer","expires_in":86400}`))
+		default:
+			w.Header().Set("X-RateLimit-Limit", "50")
+			w.Header().Set("X-RateLimit-Remaining", "49")
+			w.Header().Set("X-RateLimit-Reset", "0")
+			w.Write([]byte(`{"friendly_name":"` + r.Header.Get("Authorization") + `"}`))
+		}
+	})
+	eu := httptest.NewServer(h)
+	defer eu.Close()
+	us := httptest.NewServer(h)
+	defer us.Close()
+
+	defs, err := LoadTenantDefinitions(strings.NewReader(`[
+		{"name": "eu", "domain": "` + eu.URL + `", "client_id": "eu-client", "client_secret_env": "AUTH0_TEST_POOL_SECRET"},
+		{"name": "us", "domain": "` + us.URL + `", "client_id": "us-client", "client_secret": "us-secret"},
+		{"name": "missing", "domain": "` + us.URL + `", "client_id": "client", "client_secret_env": "AUTH0_TEST_POOL_MISSING"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("AUTH0_TEST_POOL_SECRET", "eu-secret")
+	defer os.Unsetenv("AUTH0_TEST_POOL_SECRET")
+
+	var sent int32
+	shared := &http.Client{Transport: client.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+
+	p, err := NewPool(defs, WithInsecure(), WithClient(shared))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect.Expect(t, p.Tenants(), []string{"eu", "us", "missing"})
+
+	m1, err := p.Get("eu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2, err := p.Get("eu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m1 != m2 {
+		t.Error("Expected the management client to be reused")
+	}
+	if _, err := p.Get("other"); err == nil {
+		t.Error("Expected an error getting an undefined tenant")
+	}
+
+	results := p.FanOut(context.Background(), func(ctx context.Context, tenant string, m *Management) (interface{}, error) {
+		tn, err := m.Tenant.ReadContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return tn.GetFriendlyName(), nil
+	})
+
+	expect.Expect(t, len(results), 3)
+	expect.Expect(t, results[0].Tenant, "eu")
+	expect.Expect(t, results[0].Value, "Bearer "+strings.TrimPrefix(eu.URL, "http://"))
+	expect.Expect(t, results[1].Tenant, "us")
+	expect.Expect(t, results[1].Value, "Bearer "+strings.TrimPrefix(us.URL, "http://"))
+	expect.Expect(t, results[2].Tenant, "missing")
+	if results[2].Err == nil {
+		t.Error("Expected an error for the tenant missing its secret")
+	}
+	expect.Expect(t, atomic.LoadInt32(&sent), int32(2))
+
+	r1, _ := p.Get("eu")
+	r2, _ := p.Get("us")
+	expect.Expect(t, r1.RateLimit().Remaining, 49)
+	if r1.rateLimiter == r2.rateLimiter {
+		t.Error("Expected tenants to track their rate limit separately")
+	}
+
+	if _, err := NewPool([]TenantDefinition{{Name: "a", Domain: "a"}, {Name: "a", Domain: "b"}}); err == nil {
+		t.Error("Expected an error defining a tenant twice")
+	}
+}
+
+func TestTenantDefinitionString(t *testing.T) {
+	def := TenantDefinition{Name: "us", Domain: "example.auth0.com", ClientID: "us-client", ClientSecret: "us-secret"}
+	for _, s := range []string{def.String(), fmt.Sprint(def), fmt.Sprintf("%v", &def)} {
+		if strings.Contains(s, "us-secret") {
+			t.Errorf("Expected the client secret to be redacted, got %s", s)
+		}
+		if !strings.Contains(s, "us-client") {
+			t.Errorf("Expected the client id to be kept, got %s", s)
+		}
+	}
+	expect.Expect(t, def.ClientSecret, "us-secret")
+}
@@ -6,6 +6,9 @@ type Prompt struct {
 
 	// IdentifierFirst determines if the login screen prompts for just the identifier, identifier and password first.
 	IdentifierFirst *bool `json:"identifier_first,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type PromptManager struct {
@@ -24,7 +27,7 @@ func newPromptManager(m *Management) *PromptManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Prompts/get_prompts
 func (m *PromptManager) Read(opts ...RequestOption) (p *Prompt, err error) {
-	err = m.Request("GET", m.URI("prompts"), &p, opts...)
+	err = m.Request("GET", m.URI("prompts"), &p, withOperation("Prompt.Read", opts)...)
 	return
 }
 
@@ -32,14 +35,14 @@ func (m *PromptManager) Read(opts ...RequestOption) (p *Prompt, err error) {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Prompts/patch_prompts
 func (m *PromptManager) Update(p *Prompt, opts ...RequestOption) error {
-	return m.Request("PATCH", m.URI("prompts"), p, opts...)
+	return m.Request("PATCH", m.URI("prompts"), p, withOperation("Prompt.Update", opts)...)
 }
 
 // CustomText retrieves the custom text for a specific prompt and language.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Prompts/get_custom_text_by_language
 func (m *PromptManager) CustomText(p string, l string, opts ...RequestOption) (t map[string]interface{}, err error) {
-	err = m.Request("GET", m.URI("prompts", p, "custom-text", l), &t, opts...)
+	err = m.Request("GET", m.URI("prompts", p, "custom-text", l), &t, withOperation("Prompt.CustomText", opts)...)
 	return
 }
 
@@ -47,6 +50,6 @@ func (m *PromptManager) CustomText(p string, l string, opts ...RequestOption) (t
 //
 // See: https://auth0.com/docs/api/management/v2#!/Prompts/put_custom_text_by_language
 func (m *PromptManager) SetCustomText(p string, l string, b map[string]interface{}, opts ...RequestOption) (err error) {
-	err = m.Request("PUT", m.URI("prompts", p, "custom-text", l), &b, opts...)
+	err = m.Request("PUT", m.URI("prompts", p, "custom-text", l), &b, withOperation("Prompt.SetCustomText", opts)...)
 	return
 }
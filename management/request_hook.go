@@ -0,0 +1,110 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"gopkg.in/auth0.v5/internal/client"
+)
+
+// RequestHook is notified of the requests sent to the Auth0 Management API, which
+// allows instrumenting them with tracing spans or metrics.
+//
+// BeforeRequest is called before a request is sent, and returns the context
+// the request is sent with. AfterResponse is called once a response has been
+// received, or the request failed, with the context returned by
+// BeforeRequest.
+type RequestHook interface {
+	BeforeRequest(ctx context.Context, info RequestInfo) context.Context
+	AfterResponse(ctx context.Context, info RequestInfo)
+}
+
+// RequestInfo describes a request sent to the Auth0 Management API. The
+// fields describing the response are only set when calling AfterResponse.
+type RequestInfo struct {
+	// The logical operation, made of the manager and method names, such as
+	// "User.Update". Empty when the request was not sent by a manager, nor
+	// named using Operation.
+	Operation string
+
+	// The HTTP method of the request.
+	Method string
+
+	// The path of the request, where resource identifiers are replaced by a
+	// placeholder, such as "/api/v2/users/{id}".
+	Path string
+
+	// The status code of the response, or zero when the request failed.
+	StatusCode int
+
+	// The time elapsed between sending the request and receiving the
+	// response, including any retries.
+	Latency time.Duration
+
+	// The number of times the request was retried.
+	Retries int
+
+	// The rate limit budget reported by the response headers.
+	RateLimit RateLimit
+
+	// The error which caused the request to fail, if any. Responses with an
+	// error status code are not considered a failure.
+	Err error
+}
+
+// WithRequestHook configures the management client to notify the hook of
+// every request it sends. Hooks are called in the order they were registered before
+// a request, and in the reverse order after the response.
+func WithRequestHook(hook RequestHook) ManagementOption {
+	return func(m *Management) {
+		m.hooks = append(m.hooks, hook)
+	}
+}
+
+// doWithHooks sends the request, notifying the hooks before and after.
+func (m *Management) doWithHooks(req *http.Request) (*http.Response, error) {
+	info := RequestInfo{
+		Operation: operation(req),
+		Method:    req.Method,
+		Path:      pathTemplate(req.URL.Path),
+	}
+
+	ctx := req.Context()
+	for _, h := range m.hooks {
+		ctx = h.BeforeRequest(ctx, info)
+	}
+	ctx, attempts := client.CountAttempts(ctx)
+
+	start := time.Now()
+	res, err := m.do(req.WithContext(ctx))
+	info.Latency = time.Since(start)
+	if n := attempts(); n > 1 {
+		info.Retries = n - 1
+	}
+	if res != nil {
+		info.StatusCode = res.StatusCode
+		if budget, ok := client.ParseBudget(res.Header); ok {
+			info.RateLimit = RateLimit(budget)
+		}
+	}
+	info.Err = err
+
+	for i := len(m.hooks) - 1; i >= 0; i-- {
+		m.hooks[i].AfterResponse(ctx, info)
+	}
+	return res, err
+}
+
+// pathTemplate replaces the resource identifiers of the path by a
+// placeholder.
+func pathTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if s != "" && !pathSegments[s] {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
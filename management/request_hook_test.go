@@ -0,0 +1,221 @@
+package management
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+type hookKey struct{}
+
+type recordingHook struct {
+	before []RequestInfo
+	after  []RequestInfo
+	values []interface{}
+}
+
+func (h *recordingHook) BeforeRequest(ctx context.Context, info RequestInfo) context.Context {
+	h.before = append(h.before, info)
+	return context.WithValue(ctx, hookKey{}, len(h.before))
+}
+
+func (h *recordingHook) AfterResponse(ctx context.Context, info RequestInfo) {
+	h.after = append(h.after, info)
+	h.values = append(h.values, ctx.Value(hookKey{}))
+}
+
+func TestRequestHook(t *testing.T) {
+
+	var attempts int
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("X-RateLimit-Limit", "50")
+		w.Header().Set("X-RateLimit-Remaining", "48")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.Write([]byte(`{"user_id":"auth0|123"}`))
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	hook := &recordingHook{}
+	m, err := New(s.URL,
+		WithInsecure(),
+		WithRetries(RetryPolicy{BaseDelay: time.Millisecond}),
+		WithRequestHook(hook))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.User.Read("auth0|123"); err != nil {
+		t.Fatal(err)
+	}
+
+	expect.Expect(t, len(hook.before), 1)
+	expect.Expect(t, hook.before[0], RequestInfo{
+		Operation: "User.Read",
+		Method:    "GET",
+		Path:      "/api/v2/users/{id}",
+	})
+
+	expect.Expect(t, len(hook.after), 1)
+	info := hook.after[0]
+	expect.Expect(t, info.Operation, "User.Read")
+	expect.Expect(t, info.StatusCode, http.StatusOK)
+	expect.Expect(t, info.Retries, 1)
+	expect.Expect(t, info.RateLimit, RateLimit{50, 48, time.Unix(1700000000, 0)})
+	expect.Expect(t, info.Err, nil)
+	if info.Latency <= 0 {
+		t.Errorf("Expected latency to be positive, got %s", info.Latency)
+	}
+	expect.Expect(t, hook.values, []interface{}{1})
+}
+
+func TestOperation(t *testing.T) {
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer s.Close()
+
+	hook := &recordingHook{}
+	m, err := New(s.URL, WithInsecure(), WithRequestHook(hook))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := m.User.Read("auth0|123", Context(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.User.ReadContext(ctx, "auth0|123", Operation("Custom.Read")); err != nil {
+		t.Fatal(err)
+	}
+	var v interface{}
+	if err := m.Request("GET", m.URI("stats", "daily"), &v, Operation("Custom.Stats")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Request("GET", m.URI("stats", "daily"), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	var operations []string
+	for _, info := range hook.before {
+		operations = append(operations, info.Operation)
+	}
+	expect.Expect(t, operations, []string{"User.Read", "User.Read", "Custom.Stats", ""})
+}
+
+// TestOperationNames ensures the manager methods name the requests they send
+// after themselves, using withOperation.
+func TestOperationNames(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range pkgs["management"].Files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Body == nil {
+				continue
+			}
+			star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			receiver := star.X.(*ast.Ident).Name
+			if !strings.HasSuffix(receiver, "Manager") {
+				continue
+			}
+			op := strconv.Quote(strings.TrimSuffix(receiver, "Manager") + "." + fd.Name.Name)
+			ast.Inspect(fd.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || (sel.Sel.Name != "Request" && sel.Sel.Name != "NewRequest") {
+					return true
+				}
+				if id, ok := sel.X.(*ast.Ident); !ok || id.Name != fd.Recv.List[0].Names[0].Name {
+					return true
+				}
+				var named bool
+				ast.Inspect(call, func(n ast.Node) bool {
+					if c, ok := n.(*ast.CallExpr); ok {
+						if id, ok := c.Fun.(*ast.Ident); ok && id.Name == "withOperation" {
+							if lit, ok := c.Args[0].(*ast.BasicLit); ok && lit.Value == op {
+								named = true
+							}
+						}
+					}
+					return !named
+				})
+				if !named {
+					t.Errorf("%s: request is not named %s", fset.Position(call.Pos()), op)
+				}
+				return true
+			})
+		}
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/api/v2/users/auth0|123":                           "/api/v2/users/{id}",
+		"/api/v2/users/auth0|123/roles":                     "/api/v2/users/{id}/roles",
+		"/api/v2/organizations/org_1/members/auth0|1/roles": "/api/v2/organizations/{id}/members/{id}/roles",
+		"/api/v2/guardian/factors/sms/providers/twilio":     "/api/v2/guardian/factors/sms/providers/twilio",
+		"/api/v2/jobs/users-imports":                        "/api/v2/jobs/users-imports",
+	} {
+		expect.Expect(t, pathTemplate(path), expected)
+	}
+}
+
+// TestPathSegments ensures every static path segment used by the managers is
+// known, so that it is not mistaken for a resource identifier. Run go generate
+// to update pathSegments.
+func TestPathSegments(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	uri := regexp.MustCompile(`\.URI\(([^)]*)\)`)
+	literal := regexp.MustCompile(`"([^"]*)"`)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, call := range uri.FindAllStringSubmatch(string(b), -1) {
+			for _, segment := range literal.FindAllStringSubmatch(call[1], -1) {
+				if !pathSegments[segment[1]] {
+					t.Errorf("%s: path segment %q is missing from pathSegments", file, segment[1])
+				}
+			}
+		}
+	}
+}
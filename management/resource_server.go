@@ -47,6 +47,9 @@ type ResourceServer struct {
 
 	// The dialect for the access token ["access_token" or "access_token_authz"].
 	TokenDialect *string `json:"token_dialect,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ResourceServerScope struct {
@@ -56,6 +59,9 @@ type ResourceServerScope struct {
 
 	// Description of the scope
 	Description *string `json:"description,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type ResourceServerList struct {
@@ -63,6 +69,22 @@ type ResourceServerList struct {
 	ResourceServers []*ResourceServer `json:"resource_servers"`
 }
 
+// ResourceServerIterator iterates over resource servers, fetching further pages
+// as needed.
+type ResourceServerIterator struct {
+	it *iterator
+}
+
+// Next returns the next resource server. It returns Done when there are no more
+// resource servers.
+func (i *ResourceServerIterator) Next() (*ResourceServer, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ResourceServer), nil
+}
+
 type ResourceServerManager struct {
 	*Management
 }
@@ -75,14 +97,14 @@ func newResourceServerManager(m *Management) *ResourceServerManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/post_resource_servers
 func (m *ResourceServerManager) Create(rs *ResourceServer, opts ...RequestOption) (err error) {
-	return m.Request("POST", m.URI("resource-servers"), rs, opts...)
+	return m.Request("POST", m.URI("resource-servers"), rs, withOperation("ResourceServer.Create", opts)...)
 }
 
 // Read retrieves a resource server by its id or audience.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/get_resource_servers_by_id
 func (m *ResourceServerManager) Read(id string, opts ...RequestOption) (rs *ResourceServer, err error) {
-	err = m.Request("GET", m.URI("resource-servers", id), &rs, opts...)
+	err = m.Request("GET", m.URI("resource-servers", id), &rs, withOperation("ResourceServer.Read", opts)...)
 	return
 }
 
@@ -90,39 +112,43 @@ func (m *ResourceServerManager) Read(id string, opts ...RequestOption) (rs *Reso
 //
 // See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/patch_resource_servers_by_id
 func (m *ResourceServerManager) Update(id string, rs *ResourceServer, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("resource-servers", id), rs, opts...)
+	return m.Request("PATCH", m.URI("resource-servers", id), rs, withOperation("ResourceServer.Update", opts)...)
 }
 
 // Delete a resource server.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/delete_resource_servers_by_id
 func (m *ResourceServerManager) Delete(id string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("resource-servers", id), nil, opts...)
+	return m.Request("DELETE", m.URI("resource-servers", id), nil, withOperation("ResourceServer.Delete", opts)...)
 }
 
 // List all resource server.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Resource_Servers/get_resource_servers
 func (m *ResourceServerManager) List(opts ...RequestOption) (rl *ResourceServerList, err error) {
-	err = m.Request("GET", m.URI("resource-servers"), &rl, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("resource-servers"), &rl, applyListDefaults(withOperation("ResourceServer.List", opts)))
 	return
 }
 
+// Iterate returns an iterator over all resource servers, fetching further pages
+// as the iteration advances.
+func (m *ResourceServerManager) Iterate(opts ...RequestOption) *ResourceServerIterator {
+	return &ResourceServerIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
 // Stream is a helper method which handles pagination
 func (m *ResourceServerManager) Stream(fn func(s *ResourceServer), opts ...RequestOption) error {
-	var page int
+	it := m.Iterate(opts...)
 	for {
-		l, err := m.List(append(opts, Page(page))...)
+		s, err := it.Next()
+		if err == Done {
+			return nil
+		}
 		if err != nil {
 			return err
 		}
-		for _, s := range l.ResourceServers {
-			fn(s)
-		}
-		if !l.HasNext() {
-			break
-		}
-		page++
+		fn(s)
 	}
-	return nil
 }
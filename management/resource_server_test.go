@@ -10,7 +10,7 @@ import (
 func TestResourceServer(t *testing.T) {
 
 	s := &ResourceServer{
-		Name:             auth0.Stringf("Test Resource Server (%s)", time.Now().Format(time.StampMilli)),
+		Name:             auth0.Stringf("Test Resource Server (%s)", testTime().Format(time.StampMilli)),
 		Identifier:       auth0.String("https://api.example.com/"),
 		SigningAlgorithm: auth0.String("HS256"),
 
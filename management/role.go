@@ -9,6 +9,9 @@ type Role struct {
 
 	// A description of the role created.
 	Description *string `json:"description,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type RoleList struct {
@@ -16,6 +19,20 @@ type RoleList struct {
 	Roles []*Role `json:"roles"`
 }
 
+// RoleIterator iterates over roles, fetching further pages as needed.
+type RoleIterator struct {
+	it *iterator
+}
+
+// Next returns the next role. It returns Done when there are no more roles.
+func (i *RoleIterator) Next() (*Role, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Role), nil
+}
+
 type Permission struct {
 	// The resource server that the permission is attached to.
 	ResourceServerIdentifier *string `json:"resource_server_identifier,omitempty"`
@@ -35,6 +52,22 @@ type PermissionList struct {
 	Permissions []*Permission `json:"permissions"`
 }
 
+// PermissionIterator iterates over permissions, fetching further pages as
+// needed.
+type PermissionIterator struct {
+	it *iterator
+}
+
+// Next returns the next permission. It returns Done when there are no more
+// permissions.
+func (i *PermissionIterator) Next() (*Permission, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Permission), nil
+}
+
 type RoleManager struct {
 	*Management
 }
@@ -47,14 +80,14 @@ func newRoleManager(m *Management) *RoleManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/post_roles
 func (m *RoleManager) Create(r *Role, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("roles"), r, opts...)
+	return m.Request("POST", m.URI("roles"), r, withOperation("Role.Create", opts)...)
 }
 
 // Retrieve a role.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/get_roles_by_id
 func (m *RoleManager) Read(id string, opts ...RequestOption) (r *Role, err error) {
-	err = m.Request("GET", m.URI("roles", id), &r, opts...)
+	err = m.Request("GET", m.URI("roles", id), &r, withOperation("Role.Read", opts)...)
 	return
 }
 
@@ -62,7 +95,7 @@ func (m *RoleManager) Read(id string, opts ...RequestOption) (r *Role, err error
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/patch_roles_by_id
 func (m *RoleManager) Update(id string, r *Role, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("roles", id), r, opts...)
+	return m.Request("PATCH", m.URI("roles", id), r, withOperation("Role.Update", opts)...)
 }
 
 // Delete a role.
@@ -73,17 +106,25 @@ func (m *RoleManager) Delete(id string, opts ...RequestOption) (err error) {
 	// triggers decoding of the response payload.
 	//
 	// In order to avoid Unmarshal(nil) errors, we pass an empty &Role{}.
-	return m.Request("DELETE", m.URI("roles", id), &Role{}, opts...)
+	return m.Request("DELETE", m.URI("roles", id), &Role{}, withOperation("Role.Delete", opts)...)
 }
 
 // List all roles that can be assigned to users or groups.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/get_roles
 func (m *RoleManager) List(opts ...RequestOption) (r *RoleList, err error) {
-	err = m.Request("GET", m.URI("roles"), &r, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("roles"), &r, applyListDefaults(withOperation("Role.List", opts)))
 	return
 }
 
+// Iterate returns an iterator over all roles, fetching further pages as the
+// iteration advances.
+func (m *RoleManager) Iterate(opts ...RequestOption) *RoleIterator {
+	return &RoleIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
 // AssignUsers assigns users to a role.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/post_role_users
@@ -93,39 +134,55 @@ func (m *RoleManager) AssignUsers(id string, users []*User, opts ...RequestOptio
 	for i, user := range users {
 		u["users"][i] = user.ID
 	}
-	return m.Request("POST", m.URI("roles", id, "users"), &u, opts...)
+	return m.Request("POST", m.URI("roles", id, "users"), &u, withOperation("Role.AssignUsers", opts)...)
 }
 
 // Users retrieves all users associated with a role.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/get_role_user
 func (m *RoleManager) Users(id string, opts ...RequestOption) (u *UserList, err error) {
-	err = m.Request("GET", m.URI("roles", id, "users"), &u, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("roles", id, "users"), &u, applyListDefaults(withOperation("Role.Users", opts)))
 	return
 }
 
+// IterateUsers returns an iterator over the users associated with a role,
+// fetching further pages as the iteration advances.
+func (m *RoleManager) IterateUsers(id string, opts ...RequestOption) *UserIterator {
+	return &UserIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Users(id, opts...)
+	})}
+}
+
 // AssociatePermissions associates permissions to a role.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/post_role_permission_assignment
 func (m *RoleManager) AssociatePermissions(id string, permissions []*Permission, opts ...RequestOption) error {
 	p := make(map[string][]*Permission)
 	p["permissions"] = permissions
-	return m.Request("POST", m.URI("roles", id, "permissions"), &p, opts...)
+	return m.Request("POST", m.URI("roles", id, "permissions"), &p, withOperation("Role.AssociatePermissions", opts)...)
 }
 
 // Permissions retrieves all permissions granted by a role.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/get_role_permission
 func (m *RoleManager) Permissions(id string, opts ...RequestOption) (p *PermissionList, err error) {
-	err = m.Request("GET", m.URI("roles", id, "permissions"), &p, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("roles", id, "permissions"), &p, applyListDefaults(withOperation("Role.Permissions", opts)))
 	return
 }
 
+// IteratePermissions returns an iterator over the permissions granted by a
+// role, fetching further pages as the iteration advances.
+func (m *RoleManager) IteratePermissions(id string, opts ...RequestOption) *PermissionIterator {
+	return &PermissionIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Permissions(id, opts...)
+	})}
+}
+
 // RemovePermissions removes permissions associated to a role.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Roles/delete_role_permission_assignment
 func (m *RoleManager) RemovePermissions(id string, permissions []*Permission, opts ...RequestOption) error {
 	p := make(map[string][]*Permission)
 	p["permissions"] = permissions
-	return m.Request("DELETE", m.URI("roles", id, "permissions"), &p, opts...)
+	return m.Request("DELETE", m.URI("roles", id, "permissions"), &p, withOperation("Role.RemovePermissions", opts)...)
 }
@@ -30,7 +30,7 @@ func TestRole(t *testing.T) {
 
 	s := &ResourceServer{
 		Name: auth0.Stringf("Test Role (%s)",
-			time.Now().Format(time.StampMilli)),
+			testTime().Format(time.StampMilli)),
 		Identifier: auth0.String("https://api.example.com/role"),
 		Scopes: []*ResourceServerScope{
 			{
@@ -19,6 +19,9 @@ type Rule struct {
 
 	// Enabled should be set to true if the rule is enabled, false otherwise.
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type RuleList struct {
@@ -26,6 +29,20 @@ type RuleList struct {
 	Rules []*Rule `json:"rules"`
 }
 
+// RuleIterator iterates over rules, fetching further pages as needed.
+type RuleIterator struct {
+	it *iterator
+}
+
+// Next returns the next rule. It returns Done when there are no more rules.
+func (i *RuleIterator) Next() (*Rule, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Rule), nil
+}
+
 type RuleManager struct {
 	*Management
 }
@@ -41,14 +58,14 @@ func newRuleManager(m *Management) *RuleManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Rules/post_rules
 func (m *RuleManager) Create(r *Rule, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("rules"), r, opts...)
+	return m.Request("POST", m.URI("rules"), r, withOperation("Rule.Create", opts)...)
 }
 
 // Retrieve rule details. Accepts a list of fields to include or exclude in the result.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Rules/get_rules_by_id
 func (m *RuleManager) Read(id string, opts ...RequestOption) (r *Rule, err error) {
-	err = m.Request("GET", m.URI("rules", id), &r, opts...)
+	err = m.Request("GET", m.URI("rules", id), &r, withOperation("Rule.Read", opts)...)
 	return
 }
 
@@ -56,20 +73,28 @@ func (m *RuleManager) Read(id string, opts ...RequestOption) (r *Rule, err error
 //
 // See: https://auth0.com/docs/api/management/v2#!/Rules/patch_rules_by_id
 func (m *RuleManager) Update(id string, r *Rule, opts ...RequestOption) error {
-	return m.Request("PATCH", m.URI("rules", id), r, opts...)
+	return m.Request("PATCH", m.URI("rules", id), r, withOperation("Rule.Update", opts)...)
 }
 
 // Delete a rule.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Rules/delete_rules_by_id
 func (m *RuleManager) Delete(id string, opts ...RequestOption) error {
-	return m.Request("DELETE", m.URI("rules", id), nil, opts...)
+	return m.Request("DELETE", m.URI("rules", id), nil, withOperation("Rule.Delete", opts)...)
 }
 
 // List all rules.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Rules/get_rules
 func (m *RuleManager) List(opts ...RequestOption) (r *RuleList, err error) {
-	err = m.Request("GET", m.URI("rules"), &r, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("rules"), &r, applyListDefaults(withOperation("Rule.List", opts)))
 	return
 }
+
+// Iterate returns an iterator over all rules, fetching further pages as the
+// iteration advances.
+func (m *RuleManager) Iterate(opts ...RequestOption) *RuleIterator {
+	return &RuleIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
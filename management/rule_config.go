@@ -1,5 +1,7 @@
 package management
 
+import "net/http"
+
 type RuleConfig struct {
 
 	// The key for a RuleConfigs config
@@ -21,7 +23,7 @@ func newRuleConfigManager(m *Management) *RuleConfigManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Rules_Configs/put_rules_configs_by_key
 func (m *RuleConfigManager) Upsert(key string, r *RuleConfig, opts ...RequestOption) (err error) {
-	return m.Request("PUT", m.URI("rules-configs", key), r, opts...)
+	return m.Request("PUT", m.URI("rules-configs", key), r, withOperation("RuleConfig.Upsert", opts)...)
 }
 
 // Read a rule configuration variable by key.
@@ -40,20 +42,24 @@ func (m *RuleConfigManager) Read(key string, opts ...RequestOption) (*RuleConfig
 			return r, nil
 		}
 	}
-	return nil, &managementError{404, "Not Found", "Rule config not found"}
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Err:        http.StatusText(http.StatusNotFound),
+		Message:    "Rule config not found",
+	}
 }
 
 // Delete a rule configuration variable identified by its key.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Rules_Configs/delete_rules_configs_by_key
 func (m *RuleConfigManager) Delete(key string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("rules-configs", key), nil, opts...)
+	return m.Request("DELETE", m.URI("rules-configs", key), nil, withOperation("RuleConfig.Delete", opts)...)
 }
 
 // List all rule configuration variables.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Rules_Configs/get_rules_configs
 func (m *RuleConfigManager) List(opts ...RequestOption) (r []*RuleConfig, err error) {
-	err = m.Request("GET", m.URI("rules-configs"), &r, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("rules-configs"), &r, applyListDefaults(withOperation("RuleConfig.List", opts)))
 	return
 }
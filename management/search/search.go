@@ -0,0 +1,198 @@
+// Package search builds user search queries in the v3 query syntax, taking
+// care of escaping the values searched for.
+//
+//     q := search.And(
+//         search.Term("email", "jane+doe@example.com"),
+//         search.Range("logins_count", search.Number(100), search.Exclusive(search.Number(200))),
+//         search.Not(search.Term(search.AppMetadata("plan"), "free")),
+//     )
+//     ul, err := m.User.Search(management.Query(q.String()))
+//
+// See: https://auth0.com/docs/users/search/v3/query-syntax
+package search
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query is a user search query, built using functions such as Term, Range and
+// And.
+type Query struct {
+	s  string
+	op string
+}
+
+// String returns the query string, to be used with management.Query.
+func (q Query) String() string {
+	return q.s
+}
+
+// group returns the query string of q as an operand of op, grouping it in
+// parentheses if needed.
+func (q Query) group(op string) string {
+	if q.op == "" || q.op == op {
+		return q.s
+	}
+	return "(" + q.s + ")"
+}
+
+// Term matches users whose field has the value, such as name:jane. Reserved
+// characters, including spaces, are escaped.
+func Term(field, value string) Query {
+	return Query{s: Escape(field) + ":" + Escape(value)}
+}
+
+// Phrase matches users whose field has the exact value, such as
+// name:"jane smith".
+func Phrase(field, value string) Query {
+	return Query{s: Escape(field) + ":" + quote(value)}
+}
+
+// Wildcard matches users whose field matches the pattern, in which * matches
+// any sequence of characters, such as name:jane*. Other reserved characters
+// are escaped.
+func Wildcard(field, pattern string) Query {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = Escape(part)
+	}
+	return Query{s: Escape(field) + ":" + strings.Join(parts, "*")}
+}
+
+// Exists matches users whose field is set, such as _exists_:last_login.
+func Exists(field string) Query {
+	return Query{s: "_exists_:" + Escape(field)}
+}
+
+// Range matches users whose field is between from and to, which are included
+// unless wrapped using Exclusive. A zero Bound leaves the range open, such as
+// logins_count:[100 TO *].
+func Range(field string, from, to Bound) Query {
+	lower, upper := "[", "]"
+	if from.exclusive {
+		lower = "{"
+	}
+	if to.exclusive {
+		upper = "}"
+	}
+	return Query{s: Escape(field) + ":" + lower + from.String() + " TO " + to.String() + upper}
+}
+
+// Bound is a bound of a Range, created using Time, Number or String. Its zero
+// value is an open bound.
+type Bound struct {
+	s         string
+	exclusive bool
+}
+
+// Time returns a bound at the time, compared in UTC. A zero time is an open
+// bound.
+func Time(t time.Time) Bound {
+	if t.IsZero() {
+		return Bound{}
+	}
+	return Bound{s: quote(t.UTC().Format("2006-01-02T15:04:05.000Z"))}
+}
+
+// Number returns a bound at the number.
+func Number(n float64) Bound {
+	return Bound{s: Escape(strconv.FormatFloat(n, 'f', -1, 64))}
+}
+
+// String returns a bound at the string. An empty string is an open bound.
+func String(s string) Bound {
+	return Bound{s: Escape(s)}
+}
+
+// Exclusive excludes the bound from the range.
+func Exclusive(b Bound) Bound {
+	b.exclusive = true
+	return b
+}
+
+// String returns the bound as written in a range, which is * if it is open.
+func (b Bound) String() string {
+	if b.s == "" {
+		return "*"
+	}
+	return b.s
+}
+
+// CreatedBetween matches users created from the from time, included, until
+// the to time, excluded. A zero time leaves the range open.
+func CreatedBetween(from, to time.Time) Query {
+	return Range("created_at", Time(from), Exclusive(Time(to)))
+}
+
+// LastLoginBetween matches users who last logged in from the from time,
+// included, until the to time, excluded. A zero time leaves the range open.
+func LastLoginBetween(from, to time.Time) Query {
+	return Range("last_login", Time(from), Exclusive(Time(to)))
+}
+
+// AppMetadata returns the field of a property of the app_metadata of users,
+// such as app_metadata.plan.
+func AppMetadata(path ...string) string {
+	return strings.Join(append([]string{"app_metadata"}, path...), ".")
+}
+
+// UserMetadata returns the field of a property of the user_metadata of users,
+// such as user_metadata.favorite_color.
+func UserMetadata(path ...string) string {
+	return strings.Join(append([]string{"user_metadata"}, path...), ".")
+}
+
+// And matches users matching every query.
+func And(queries ...Query) Query {
+	return joinQueries("AND", queries)
+}
+
+// Or matches users matching any of the queries.
+func Or(queries ...Query) Query {
+	return joinQueries("OR", queries)
+}
+
+// Not matches users not matching the query.
+func Not(q Query) Query {
+	return Query{s: "NOT " + q.group(""), op: "NOT"}
+}
+
+func joinQueries(op string, queries []Query) Query {
+	var operands []Query
+	for _, q := range queries {
+		if q.s != "" {
+			operands = append(operands, q)
+		}
+	}
+	if len(operands) == 1 {
+		return operands[0]
+	}
+	s := make([]string, len(operands))
+	for i, q := range operands {
+		s[i] = q.group(op)
+	}
+	return Query{s: strings.Join(s, " "+op+" "), op: op}
+}
+
+// reserved are the characters escaped by Escape.
+const reserved = `+-=&|><!(){}[]^"~*?:\/ `
+
+// Escape escapes the characters of s reserved by the query syntax, so
+// that s is matched as it is.
+func Escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(reserved, r) || r == '\t' || r == '\n' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// quote returns s as a phrase, escaping the quotes and backslashes it holds.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,41 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestSearch(t *testing.T) {
+
+	date := time.Date(2021, 3, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	for _, test := range []struct {
+		query Query
+		want  string
+	}{
+		{Term("email", "jane+doe@example.com"), `email:jane\+doe@example.com`},
+		{Term("name", `jane "j:d" doe`), `name:jane\ \"j\:d\"\ doe`},
+		{Phrase("name", `jane "jd" doe\`), `name:"jane \"jd\" doe\\"`},
+		{Wildcard("name", "j(a)ne*"), `name:j\(a\)ne*`},
+		{Exists("last_login"), `_exists_:last_login`},
+		{Range("logins_count", Number(100), Exclusive(Number(200))), `logins_count:[100 TO 200}`},
+		{Range("logins_count", Exclusive(Number(100)), Bound{}), `logins_count:{100 TO *]`},
+		{CreatedBetween(date, time.Time{}), `created_at:["2021-03-01T11:30:00.000Z" TO *}`},
+		{LastLoginBetween(time.Time{}, date), `last_login:[* TO "2021-03-01T11:30:00.000Z"}`},
+		{Term(AppMetadata("plan"), "free"), `app_metadata.plan:free`},
+		{Term(UserMetadata("address", "city"), "Paris"), `user_metadata.address.city:Paris`},
+		{And(Term("a", "1"), Term("b", "2"), And(Term("c", "3"))), `a:1 AND b:2 AND c:3`},
+		{And(Term("a", "1"), Or(Term("b", "2"), Term("c", "3"))), `a:1 AND (b:2 OR c:3)`},
+		{Or(And(Term("a", "1"), Term("b", "2")), Not(Term("c", "3"))), `(a:1 AND b:2) OR (NOT c:3)`},
+		{Not(Or(Term("a", "1"), Term("b", "2"))), `NOT (a:1 OR b:2)`},
+		{And(Query{}, Term("a", "1")), `a:1`},
+		{Or(), ``},
+		{Range("created_at", Time(date), Time(time.Time{})), `created_at:["2021-03-01T11:30:00.000Z" TO *]`},
+		{Range("name", String("a b"), String("")), `name:[a\ b TO *]`},
+		{Range("score", Number(-1.5), Number(3)), `score:[\-1.5 TO 3]`},
+	} {
+		expect.Expect(t, test.query.String(), test.want)
+	}
+}
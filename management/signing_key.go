@@ -53,7 +53,7 @@ func newSigningKeyManager(m *Management) *SigningKeyManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Keys/get_signing_keys
 func (m *SigningKeyManager) List(opts ...RequestOption) (ks []*SigningKey, err error) {
-	err = m.Request("GET", m.URI("keys", "signing"), &ks, opts...)
+	err = m.Request("GET", m.URI("keys", "signing"), &ks, withOperation("SigningKey.List", opts)...)
 	return
 }
 
@@ -61,7 +61,7 @@ func (m *SigningKeyManager) List(opts ...RequestOption) (ks []*SigningKey, err e
 //
 // See: https://auth0.com/docs/api/management/v2#!/Keys/get_signing_key
 func (m *SigningKeyManager) Read(kid string, opts ...RequestOption) (k *SigningKey, err error) {
-	err = m.Request("GET", m.URI("keys", "signing", kid), &k, opts...)
+	err = m.Request("GET", m.URI("keys", "signing", kid), &k, withOperation("SigningKey.Read", opts)...)
 	return
 }
 
@@ -69,7 +69,7 @@ func (m *SigningKeyManager) Read(kid string, opts ...RequestOption) (k *SigningK
 //
 // See: https://auth0.com/docs/api/management/v2#!/Keys/post_signing_keys
 func (m *SigningKeyManager) Rotate(opts ...RequestOption) (k *SigningKey, err error) {
-	err = m.Request("POST", m.URI("keys", "signing", "rotate"), &k, opts...)
+	err = m.Request("POST", m.URI("keys", "signing", "rotate"), &k, withOperation("SigningKey.Rotate", opts)...)
 	return
 }
 
@@ -77,6 +77,6 @@ func (m *SigningKeyManager) Rotate(opts ...RequestOption) (k *SigningKey, err er
 //
 // See: https://auth0.com/docs/api/management/v2#!/Keys/put_signing_keys
 func (m *SigningKeyManager) Revoke(kid string, opts ...RequestOption) (k *SigningKey, err error) {
-	err = m.Request("PUT", m.URI("keys", "signing", kid, "revoke"), &k, opts...)
+	err = m.Request("PUT", m.URI("keys", "signing", kid, "revoke"), &k, withOperation("SigningKey.Revoke", opts)...)
 	return
 }
@@ -15,7 +15,7 @@ func newStatManager(m *Management) *StatManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Stats/get_active_users
 func (m *StatManager) ActiveUsers(opts ...RequestOption) (i int, err error) {
-	err = m.Request("GET", m.URI("stats", "active-users"), &i, opts...)
+	err = m.Request("GET", m.URI("stats", "active-users"), &i, withOperation("Stat.ActiveUsers", opts)...)
 	return
 }
 
@@ -34,6 +34,6 @@ type DailyStat struct {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Stats/get_daily
 func (m *StatManager) Daily(opts ...RequestOption) (ds []*DailyStat, err error) {
-	err = m.Request("GET", m.URI("stats", "daily"), &ds, opts...)
+	err = m.Request("GET", m.URI("stats", "daily"), &ds, withOperation("Stat.Daily", opts)...)
 	return
 }
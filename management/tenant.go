@@ -74,6 +74,9 @@ type Tenant struct {
 
 	// Supported locales for the UI
 	EnabledLocales []interface{} `json:"enabled_locales,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (t *Tenant) MarshalJSON() ([]byte, error) {
@@ -114,7 +117,39 @@ func (t *Tenant) MarshalJSON() ([]byte, error) {
 
 	}
 
-	return json.Marshal(w)
+	b, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(b, t.Extra)
+}
+
+func (t *Tenant) UnmarshalJSON(b []byte) error {
+
+	type tenant Tenant
+	type tenantWrapper struct {
+		*tenant
+		SessionLifetimeInMinutes     *int `json:"session_lifetime_in_minutes,omitempty"`
+		IdleSessionLifetimeInMinutes *int `json:"idle_session_lifetime_in_minutes,omitempty"`
+	}
+
+	w := &tenantWrapper{(*tenant)(t), nil, nil}
+
+	err := json.Unmarshal(b, w)
+	if err != nil {
+		return err
+	}
+
+	if t.SessionLifetime == nil && w.SessionLifetimeInMinutes != nil {
+		t.SessionLifetime = auth0.Float64(float64(*w.SessionLifetimeInMinutes) / 60.0)
+	}
+
+	if t.IdleSessionLifetime == nil && w.IdleSessionLifetimeInMinutes != nil {
+		t.IdleSessionLifetime = auth0.Float64(float64(*w.IdleSessionLifetimeInMinutes) / 60.0)
+	}
+
+	t.Extra, err = unmarshalExtra(b, w)
+	return err
 }
 
 type TenantChangePassword struct {
@@ -123,6 +158,8 @@ type TenantChangePassword struct {
 	// Replace default change password page with a custom HTML (Liquid syntax is
 	// supported).
 	HTML *string `json:"html,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type TenantGuardianMFAPage struct {
@@ -131,6 +168,8 @@ type TenantGuardianMFAPage struct {
 	// Replace default Guardian page with a custom HTML (Liquid syntax is
 	// supported).
 	HTML *string `json:"html,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type TenantErrorPage struct {
@@ -142,6 +181,8 @@ type TenantErrorPage struct {
 	ShowLogLink *bool `json:"show_log_link,omitempty"`
 	// Redirect to specified url instead of show the default error page
 	URL *string `json:"url,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type TenantFlags struct {
@@ -200,10 +241,15 @@ type TenantFlags struct {
 	// If enabled, this will use the scope description when generating a consent
 	// prompt. Otherwise the scope name is used.
 	UseScopeDescriptionsForConsent *bool `json:"use_scope_descriptions_for_consent,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type TenantUniversalLogin struct {
 	Colors *TenantUniversalLoginColors `json:"colors,omitempty"`
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type TenantUniversalLoginColors struct {
@@ -221,6 +267,9 @@ type TenantUniversalLoginColors struct {
 	// Only one of PageBackground and PageBackgroundGradient should be set. If
 	// both fields are set, PageBackground takes priority.
 	PageBackgroundGradient *BrandingPageBackgroundGradient `json:"-"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 func (c *TenantUniversalLoginColors) MarshalJSON() ([]byte, error) {
@@ -240,7 +289,11 @@ func (c *TenantUniversalLoginColors) MarshalJSON() ([]byte, error) {
 		alias.RawPageBackground = c.PageBackgroundGradient
 	}
 
-	return json.Marshal(alias)
+	b, err := json.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(b, c.Extra)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -287,7 +340,8 @@ func (c *TenantUniversalLoginColors) UnmarshalJSON(data []byte) error {
 		}
 	}
 
-	return nil
+	c.Extra, err = unmarshalExtra(data, alias)
+	return err
 }
 
 type TenantDeviceFlow struct {
@@ -297,6 +351,9 @@ type TenantDeviceFlow struct {
 	// The mask used to format the generated User Code to a friendly, readable
 	// format with possible spaces or hyphens
 	Mask *string `json:"mask,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 type TenantManager struct {
@@ -312,7 +369,7 @@ func newTenantManager(m *Management) *TenantManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Tenants/get_settings
 func (m *TenantManager) Read(opts ...RequestOption) (t *Tenant, err error) {
-	err = m.Request("GET", m.URI("tenants", "settings"), &t, opts...)
+	err = m.Request("GET", m.URI("tenants", "settings"), &t, withOperation("Tenant.Read", opts)...)
 	return
 }
 
@@ -320,5 +377,5 @@ func (m *TenantManager) Read(opts ...RequestOption) (t *Tenant, err error) {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Tenants/patch_settings
 func (m *TenantManager) Update(t *Tenant, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("tenants", "settings"), t, opts...)
+	return m.Request("PATCH", m.URI("tenants", "settings"), t, withOperation("Tenant.Update", opts)...)
 }
@@ -50,12 +50,12 @@ func newTicketManager(m *Management) *TicketManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Tickets/post_email_verification
 func (m *TicketManager) VerifyEmail(t *Ticket, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("tickets", "email-verification"), t, opts...)
+	return m.Request("POST", m.URI("tickets", "email-verification"), t, withOperation("Ticket.VerifyEmail", opts)...)
 }
 
 // ChangePassword creates a password change ticket for a user.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Tickets/post_password_change
 func (m *TicketManager) ChangePassword(t *Ticket, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("tickets", "password-change"), t, opts...)
+	return m.Request("POST", m.URI("tickets", "password-change"), t, withOperation("Ticket.ChangePassword", opts)...)
 }
@@ -104,6 +104,9 @@ type User struct {
 
 	// Total number of logins this user has performed. Read only, cannot be modified.
 	LoginsCount *int64 `json:"logins_count,omitempty"`
+
+	// Properties unknown to the SDK.
+	Extra map[string]interface{} `json:"-"`
 }
 
 // UnmarshalJSON is a custom deserializer for the User type.
@@ -139,7 +142,8 @@ func (u *User) UnmarshalJSON(b []byte) error {
 		alias.EmailVerified = &emailVerified
 	}
 
-	return nil
+	u.Extra, err = unmarshalExtra(b, alias)
+	return err
 }
 
 func (u *User) MarshalJSON() ([]byte, error) {
@@ -154,7 +158,11 @@ func (u *User) MarshalJSON() ([]byte, error) {
 		alias.RawEmailVerified = u.EmailVerified
 	}
 
-	return json.Marshal(alias)
+	b, err := json.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+	return marshalExtra(b, u.Extra)
 }
 
 // UserIdentityLink contains the data needed for linking an identity to a given user.
@@ -276,6 +284,20 @@ type UserList struct {
 	Users []*User `json:"users"`
 }
 
+// UserIterator iterates over users, fetching further pages as needed.
+type UserIterator struct {
+	it itemIterator
+}
+
+// Next returns the next user. It returns Done when there are no more users.
+func (i *UserIterator) Next() (*User, error) {
+	v, err := i.it.next()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*User), nil
+}
+
 // UserManager manages Auth0 User resources.
 type UserManager struct {
 	*Management
@@ -295,14 +317,14 @@ func newUserManager(m *Management) *UserManager {
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/post_users
 func (m *UserManager) Create(u *User, opts ...RequestOption) error {
-	return m.Request("POST", m.URI("users"), u, opts...)
+	return m.Request("POST", m.URI("users"), u, withOperation("User.Create", opts)...)
 }
 
 // Read user details for a given user_id.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_users_by_id
 func (m *UserManager) Read(id string, opts ...RequestOption) (u *User, err error) {
-	err = m.Request("GET", m.URI("users", id), &u, opts...)
+	err = m.Request("GET", m.URI("users", id), &u, withOperation("User.Read", opts)...)
 	return
 }
 
@@ -328,21 +350,21 @@ func (m *UserManager) Read(id string, opts ...RequestOption) (u *User, err error
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/patch_users_by_id
 func (m *UserManager) Update(id string, u *User, opts ...RequestOption) (err error) {
-	return m.Request("PATCH", m.URI("users", id), u, opts...)
+	return m.Request("PATCH", m.URI("users", id), u, withOperation("User.Update", opts)...)
 }
 
 // Delete a single user based on its id.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/delete_users_by_id
 func (m *UserManager) Delete(id string, opts ...RequestOption) (err error) {
-	return m.Request("DELETE", m.URI("users", id), nil, opts...)
+	return m.Request("DELETE", m.URI("users", id), nil, withOperation("User.Delete", opts)...)
 }
 
 // List all users. This method forces the `include_totals` option.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_users
 func (m *UserManager) List(opts ...RequestOption) (ul *UserList, err error) {
-	err = m.Request("GET", m.URI("users"), &ul, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("users"), &ul, applyListDefaults(withOperation("User.List", opts)))
 	return
 }
 
@@ -351,6 +373,24 @@ func (m *UserManager) Search(opts ...RequestOption) (ul *UserList, err error) {
 	return m.List(opts...)
 }
 
+// Iterate returns an iterator over all users, fetching further pages as the
+// iteration advances.
+func (m *UserManager) Iterate(opts ...RequestOption) *UserIterator {
+	return &UserIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.List(opts...)
+	})}
+}
+
+// SearchAll returns an iterator over all the users matching the query set
+// using Query, even beyond the 1000 users a search can return.
+//
+// Searches matching too many users are split into searches of users created
+// within successive time windows, narrowed until each matches few enough
+// users. Users created once the iteration has started are not returned.
+func (m *UserManager) SearchAll(opts ...RequestOption) *UserIterator {
+	return &UserIterator{newUserSearch(m, opts)}
+}
+
 // ListByEmail retrieves all users matching a given email.
 //
 // If Auth0 is the identify provider (idP), the email address associated with a
@@ -367,7 +407,7 @@ func (m *UserManager) Search(opts ...RequestOption) (ul *UserList, err error) {
 // See: https://auth0.com/docs/api/management/v2#!/Users_By_Email/get_users_by_email
 func (m *UserManager) ListByEmail(email string, opts ...RequestOption) (us []*User, err error) {
 	opts = append(opts, Parameter("email", email))
-	err = m.Request("GET", m.URI("users-by-email"), &us, opts...)
+	err = m.Request("GET", m.URI("users-by-email"), &us, withOperation("User.ListByEmail", opts)...)
 	return
 }
 
@@ -375,10 +415,18 @@ func (m *UserManager) ListByEmail(email string, opts ...RequestOption) (us []*Us
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_user_roles
 func (m *UserManager) Roles(id string, opts ...RequestOption) (r *RoleList, err error) {
-	err = m.Request("GET", m.URI("users", id, "roles"), &r, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("users", id, "roles"), &r, applyListDefaults(withOperation("User.Roles", opts)))
 	return
 }
 
+// IterateRoles returns an iterator over the roles associated with a user,
+// fetching further pages as the iteration advances.
+func (m *UserManager) IterateRoles(id string, opts ...RequestOption) *RoleIterator {
+	return &RoleIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Roles(id, opts...)
+	})}
+}
+
 // AssignRoles assigns roles to a user.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/post_user_roles
@@ -388,7 +436,7 @@ func (m *UserManager) AssignRoles(id string, roles []*Role, opts ...RequestOptio
 	for i, role := range roles {
 		r["roles"][i] = role.ID
 	}
-	return m.Request("POST", m.URI("users", id, "roles"), &r, opts...)
+	return m.Request("POST", m.URI("users", id, "roles"), &r, withOperation("User.AssignRoles", opts)...)
 }
 
 // RemoveRoles removes any roles associated to a user.
@@ -400,24 +448,32 @@ func (m *UserManager) RemoveRoles(id string, roles []*Role, opts ...RequestOptio
 	for i, role := range roles {
 		r["roles"][i] = role.ID
 	}
-	return m.Request("DELETE", m.URI("users", id, "roles"), &r, opts...)
+	return m.Request("DELETE", m.URI("users", id, "roles"), &r, withOperation("User.RemoveRoles", opts)...)
 }
 
 // Permissions lists the permissions associated to the user.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_permissions
 func (m *UserManager) Permissions(id string, opts ...RequestOption) (p *PermissionList, err error) {
-	err = m.Request("GET", m.URI("users", id, "permissions"), &p, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("users", id, "permissions"), &p, applyListDefaults(withOperation("User.Permissions", opts)))
 	return
 }
 
+// IteratePermissions returns an iterator over the permissions granted to a
+// user, fetching further pages as the iteration advances.
+func (m *UserManager) IteratePermissions(id string, opts ...RequestOption) *PermissionIterator {
+	return &PermissionIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Permissions(id, opts...)
+	})}
+}
+
 // AssignPermissions assigns permissions to the user.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/post_permissions
 func (m *UserManager) AssignPermissions(id string, permissions []*Permission, opts ...RequestOption) error {
 	p := make(map[string][]*Permission)
 	p["permissions"] = permissions
-	return m.Request("POST", m.URI("users", id, "permissions"), &p, opts...)
+	return m.Request("POST", m.URI("users", id, "permissions"), &p, withOperation("User.AssignPermissions", opts)...)
 }
 
 // RemovePermissions removes any permissions associated to a user.
@@ -426,7 +482,7 @@ func (m *UserManager) AssignPermissions(id string, permissions []*Permission, op
 func (m *UserManager) RemovePermissions(id string, permissions []*Permission, opts ...RequestOption) error {
 	p := make(map[string][]*Permission)
 	p["permissions"] = permissions
-	return m.Request("DELETE", m.URI("users", id, "permissions"), &p, opts...)
+	return m.Request("DELETE", m.URI("users", id, "permissions"), &p, withOperation("User.RemovePermissions", opts)...)
 }
 
 // Blocks retrieves a list of blocked IP addresses of a particular user using the
@@ -435,7 +491,7 @@ func (m *UserManager) RemovePermissions(id string, permissions []*Permission, op
 // See: https://auth0.com/docs/api/management/v2#!/User_Blocks/get_user_blocks_by_id
 func (m *UserManager) Blocks(id string, opts ...RequestOption) ([]*UserBlock, error) {
 	b := new(userBlock)
-	err := m.Request("GET", m.URI("user-blocks", id), &b, opts...)
+	err := m.Request("GET", m.URI("user-blocks", id), &b, withOperation("User.Blocks", opts)...)
 	return b.BlockedFor, err
 }
 
@@ -446,7 +502,7 @@ func (m *UserManager) Blocks(id string, opts ...RequestOption) ([]*UserBlock, er
 func (m *UserManager) BlocksByIdentifier(identifier string, opts ...RequestOption) ([]*UserBlock, error) {
 	b := new(userBlock)
 	opts = append(opts, Parameter("identifier", identifier))
-	err := m.Request("GET", m.URI("user-blocks"), &b, opts...)
+	err := m.Request("GET", m.URI("user-blocks"), &b, withOperation("User.BlocksByIdentifier", opts)...)
 	return b.BlockedFor, err
 }
 
@@ -457,7 +513,7 @@ func (m *UserManager) BlocksByIdentifier(identifier string, opts ...RequestOptio
 //
 // See: https://auth0.com/docs/api/management/v2#!/User_Blocks/delete_user_blocks_by_id
 func (m *UserManager) Unblock(id string, opts ...RequestOption) error {
-	return m.Request("DELETE", m.URI("user-blocks", id), nil, opts...)
+	return m.Request("DELETE", m.URI("user-blocks", id), nil, withOperation("User.Unblock", opts)...)
 }
 
 // Unblock a user that was blocked due to an excessive amount of incorrectly
@@ -468,14 +524,14 @@ func (m *UserManager) Unblock(id string, opts ...RequestOption) error {
 // See: https://auth0.com/docs/api/management/v2#!/User_Blocks/delete_user_blocks
 func (m *UserManager) UnblockByIdentifier(identifier string, opts ...RequestOption) error {
 	opts = append(opts, Parameter("identifier", identifier))
-	return m.Request("DELETE", m.URI("user-blocks"), nil, opts...)
+	return m.Request("DELETE", m.URI("user-blocks"), nil, withOperation("User.UnblockByIdentifier", opts)...)
 }
 
 // Enrollments retrieves all Guardian enrollments for a user.
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_enrollments
 func (m *UserManager) Enrollments(id string, opts ...RequestOption) (enrolls []*UserEnrollment, err error) {
-	err = m.Request("GET", m.URI("users", id, "enrollments"), &enrolls, opts...)
+	err = m.Request("GET", m.URI("users", id, "enrollments"), &enrolls, withOperation("User.Enrollments", opts)...)
 	return
 }
 
@@ -484,7 +540,7 @@ func (m *UserManager) Enrollments(id string, opts ...RequestOption) (enrolls []*
 // See: https://auth0.com/docs/api/management/v2#!/Users/post_recovery_code_regeneration
 func (m *UserManager) RegenerateRecoveryCode(id string, opts ...RequestOption) (*UserRecoveryCode, error) {
 	r := new(UserRecoveryCode)
-	err := m.Request("POST", m.URI("users", id, "recovery-code-regeneration"), &r, opts...)
+	err := m.Request("POST", m.URI("users", id, "recovery-code-regeneration"), &r, withOperation("User.RegenerateRecoveryCode", opts)...)
 	return r, err
 }
 
@@ -499,7 +555,7 @@ func (m *UserManager) InvalidateRememberBrowser(id string, opts ...RequestOption
 		"actions",
 		"invalidate-remember-browser",
 	)
-	err := m.Request("POST", uri, nil, opts...)
+	err := m.Request("POST", uri, nil, withOperation("User.InvalidateRememberBrowser", opts)...)
 	return err
 }
 
@@ -507,18 +563,18 @@ func (m *UserManager) InvalidateRememberBrowser(id string, opts ...RequestOption
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/post_identities
 func (m *UserManager) Link(id string, il *UserIdentityLink, opts ...RequestOption) (uIDs []UserIdentity, err error) {
-	req, err := m.NewRequest("POST", m.URI("users", id, "identities"), il, opts...)
+	req, err := m.NewRequest("POST", m.URI("users", id, "identities"), il, withOperation("User.Link", opts)...)
 	if err != nil {
 		return uIDs, err
 	}
 
-	res, err := m.Do(req)
+	res, err := m.Do(withPayload(req, il))
 	if err != nil {
 		return uIDs, err
 	}
 
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
-		return uIDs, newError(res.Body)
+		return uIDs, newError(res)
 	}
 
 	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusAccepted {
@@ -536,6 +592,14 @@ func (m *UserManager) Link(id string, il *UserIdentityLink, opts ...RequestOptio
 //
 // See: https://auth0.com/docs/api/management/v2#!/Users/get_organizations
 func (m *UserManager) Organizations(id string, opts ...RequestOption) (p *OrganizationList, err error) {
-	err = m.Request("GET", m.URI("users", id, "organizations"), &p, applyListDefaults(opts))
+	err = m.Request("GET", m.URI("users", id, "organizations"), &p, applyListDefaults(withOperation("User.Organizations", opts)))
 	return
 }
+
+// IterateOrganizations returns an iterator over the organizations a user is a
+// member of, fetching further pages as the iteration advances.
+func (m *UserManager) IterateOrganizations(id string, opts ...RequestOption) *OrganizationIterator {
+	return &OrganizationIterator{newIterator(opts, func(opts ...RequestOption) (interface{}, error) {
+		return m.Organizations(id, opts...)
+	})}
+}
@@ -0,0 +1,196 @@
+package management
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DownloadUsers exports users via a long-running job like ExportUsers, waits
+// for the job to complete, and returns the exported users. They are decoded
+// as the file is downloaded, which must be closed once done.
+//
+// The file is downloaded using the client configured by WithDownloadClient.
+// When the job is only planned, as with WithDryRun, the returned export holds
+// no users.
+func (m *JobManager) DownloadUsers(j *Job, opts ...RequestOption) (*UserExport, error) {
+	ctx := requestContext(opts)
+
+	if err := m.ExportUsers(j, opts...); err != nil {
+		return nil, err
+	}
+	if j.GetID() == "" {
+		// The job was planned in dry-run mode, rather than submitted.
+		return &UserExport{Job: j, body: http.NoBody, json: json.NewDecoder(http.NoBody)}, nil
+	}
+	if err := m.Wait(j, nil, opts...); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", j.GetLocation(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.download.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		return nil, newError(res)
+	}
+
+	e := &UserExport{Job: j, body: res.Body}
+	r := bufio.NewReader(res.Body)
+	var body io.Reader = r
+	if magic, _ := r.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		if body, err = gzip.NewReader(r); err != nil {
+			res.Body.Close()
+			return nil, err
+		}
+	}
+	if j.GetFormat() == "json" {
+		e.json = json.NewDecoder(body)
+	} else {
+		e.csv = csv.NewReader(body)
+		e.csv.FieldsPerRecord = -1
+	}
+	return e, nil
+}
+
+// UserExport reads the users exported by a job, as returned by
+// JobManager.DownloadUsers.
+type UserExport struct {
+	// The completed export job.
+	Job *Job
+
+	body   io.Closer
+	json   *json.Decoder
+	csv    *csv.Reader
+	header []string
+}
+
+// Next returns the next exported user. It returns Done when there are no more
+// users.
+//
+// Exported fields which are not properties of a user, such as those renamed
+// using export_as, are kept in the Extra field of the user.
+func (e *UserExport) Next() (*User, error) {
+	var u *User
+	if e.json != nil {
+		if !e.json.More() {
+			return nil, Done
+		}
+		err := e.json.Decode(&u)
+		return u, err
+	}
+
+	r, err := e.NextRecord()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(csvUser(r))
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &u)
+	return u, err
+}
+
+// NextRecord returns the fields of the next exported user, such as when
+// exporting custom fields. It returns Done when there are no more users.
+//
+// The fields of users exported as CSV are strings, keyed by the column names.
+func (e *UserExport) NextRecord() (map[string]interface{}, error) {
+	var r map[string]interface{}
+	if e.json != nil {
+		if !e.json.More() {
+			return nil, Done
+		}
+		err := e.json.Decode(&r)
+		return r, err
+	}
+
+	if e.header == nil {
+		header, err := e.csv.Read()
+		if err == io.EOF {
+			return nil, Done
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(header) > 0 {
+			header[0] = strings.TrimPrefix(header[0], "\ufeff")
+		}
+		e.header = header
+	}
+	values, err := e.csv.Read()
+	if err == io.EOF {
+		return nil, Done
+	}
+	if err != nil {
+		return nil, err
+	}
+	r = make(map[string]interface{}, len(values))
+	for i, v := range values {
+		if i < len(e.header) {
+			r[e.header[i]] = v
+		}
+	}
+	return r, nil
+}
+
+// Close closes the downloaded file.
+func (e *UserExport) Close() error {
+	return e.body.Close()
+}
+
+// csvUser converts the string fields of a user exported as CSV to the types of
+// the User fields they match, dropping empty ones.
+func csvUser(r map[string]interface{}) map[string]interface{} {
+	types := make(map[string]reflect.Type)
+	typ := reflect.TypeOf(User{})
+	for i := 0; i < typ.NumField(); i++ {
+		if name, _ := jsonField(typ.Field(i)); name != "" {
+			types[name] = typ.Field(i).Type
+		}
+	}
+
+	u := make(map[string]interface{}, len(r))
+	for k, v := range r {
+		s, _ := v.(string)
+		if s == "" {
+			continue
+		}
+		u[k] = s
+		t, ok := types[k]
+		if !ok {
+			continue
+		}
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Int, reflect.Int64:
+			if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+				u[k] = n
+			}
+		case reflect.Bool:
+			if b, err := strconv.ParseBool(s); err == nil {
+				u[k] = b
+			}
+		case reflect.Map, reflect.Slice:
+			var v interface{}
+			if err := json.Unmarshal([]byte(s), &v); err == nil {
+				u[k] = v
+			}
+		}
+	}
+	return u
+}
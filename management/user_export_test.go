@@ -0,0 +1,166 @@
+package management
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestUserExport(t *testing.T) {
+
+	defer func(delay time.Duration) { jobPollDelay = delay }(jobPollDelay)
+	jobPollDelay = time.Millisecond
+
+	files := map[string]string{
+		"json": `{"user_id":"auth0|1","email":"a@example.com","logins_count":3,"department":"sales"}
+{"user_id":"auth0|2","email":"b@example.com","app_metadata":{"plan":"free"}}
+`,
+		"csv": "\ufeffuser_id,email,logins_count,email_verified,department\n" +
+			"auth0|1,a@example.com,3,true,sales\n" +
+			"auth0|2,\"b,c@example.com\",,false,\n",
+	}
+
+	var (
+		format    string
+		reads     int32
+		authLeaks int32
+		srv       *httptest.Server
+	)
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/jobs/users-exports":
+			w.Write([]byte(`{"id":"job_1","status":"pending","format":"` + format + `"}`))
+		case "/api/v2/jobs/job_1":
+			if atomic.AddInt32(&reads, 1) < 2 {
+				w.Write([]byte(`{"id":"job_1","status":"processing","format":"` + format + `"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"job_1","status":"completed","format":"` + format + `","location":"` + srv.URL + `/download"}`))
+		case "/download":
+			if r.Header.Get("Authorization") != "" {
+				atomic.AddInt32(&authLeaks, 1)
+			}
+			gz := gzip.NewWriter(w)
+			gz.Write([]byte(files[format]))
+			gz.Close()
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	m, err := New(srv.URL, WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		format = "json"
+		atomic.StoreInt32(&reads, 0)
+
+		e, err := m.Job.DownloadUsers(&Job{Format: auth0.String("json")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer e.Close()
+		expect.Expect(t, e.Job.GetStatus(), "completed")
+
+		u, err := e.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, u.GetID(), "auth0|1")
+		expect.Expect(t, u.GetLoginsCount(), int64(3))
+		expect.Expect(t, u.Extra, map[string]interface{}{"department": "sales"})
+
+		r, err := e.NextRecord()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, r["app_metadata"], map[string]interface{}{"plan": "free"})
+
+		if _, err := e.Next(); err != Done {
+			t.Errorf("Expected Done, got %v", err)
+		}
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		format = "csv"
+		atomic.StoreInt32(&reads, 0)
+
+		e, err := m.Job.DownloadUsersContext(context.Background(), &Job{Format: auth0.String("csv")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer e.Close()
+
+		u, err := e.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, u.GetID(), "auth0|1")
+		expect.Expect(t, u.GetLoginsCount(), int64(3))
+		expect.Expect(t, u.GetEmailVerified(), true)
+		expect.Expect(t, u.Extra, map[string]interface{}{"department": "sales"})
+
+		r, err := e.NextRecord()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect.Expect(t, r, map[string]interface{}{
+			"user_id":        "auth0|2",
+			"email":          "b,c@example.com",
+			"logins_count":   "",
+			"email_verified": "false",
+			"department":     "",
+		})
+
+		if _, err := e.NextRecord(); err != Done {
+			t.Errorf("Expected Done, got %v", err)
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		format = "json"
+		atomic.StoreInt32(&reads, -100)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := m.Job.DownloadUsersContext(ctx, &Job{})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		atomic.StoreInt32(&reads, 0)
+
+		var plan Plan
+		m, err := New(srv.URL, WithInsecure(), WithDryRun(&plan))
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := m.Job.DownloadUsers(&Job{Format: auth0.String("json")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := e.Next(); err != Done {
+			t.Errorf("Expected Done, got %v", err)
+		}
+		if err := e.Close(); err != nil {
+			t.Error(err)
+		}
+		expect.Expect(t, len(plan.Mutations()), 1)
+		expect.Expect(t, atomic.LoadInt32(&reads), int32(0))
+	})
+
+	expect.Expect(t, atomic.LoadInt32(&authLeaks), int32(0))
+}
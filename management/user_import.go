@@ -0,0 +1,279 @@
+package management
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// UserImporter imports users through as many import jobs as needed, so that
+// the users file of each job stays within the size accepted by Auth0.
+//
+//     i := &management.UserImporter{Job: m.Job}
+//     report, err := i.ImportFrom(ctx, &management.Job{ConnectionID: auth0.String(id)}, file)
+//
+// The zero value of any field but Job selects its default.
+type UserImporter struct {
+	// The job manager submitting the import jobs.
+	Job *JobManager
+
+	// The maximum size of the users file of a job, in bytes. Defaults to
+	// 500KB.
+	ChunkSize int
+
+	// The maximum number of import jobs run concurrently. Defaults to 2.
+	MaxJobs int
+}
+
+// UserImportReport reports the outcome of a UserImporter.
+type UserImportReport struct {
+	// The number of users read.
+	Users int
+
+	// The import jobs, in the order they completed.
+	Jobs []*Job
+
+	// The users which could not be imported, in the order they were read.
+	Failures []*UserImportFailure
+}
+
+// UserImportFailure is a user which could not be imported by a UserImporter.
+type UserImportFailure struct {
+	// The position of the user among those read, starting at 0, or -1 if the
+	// user reported by the job could not be matched to one of them.
+	Index int
+
+	// The user as read.
+	User map[string]interface{}
+
+	// The reasons the user was rejected by the import job.
+	Errors []*JobErrorDetails
+
+	// The error preventing the user from being imported otherwise, such as the
+	// import job failing.
+	Err error
+}
+
+// ImportFrom imports the users read from r, either a JSON array of users like
+// the file of an import job, or a stream of JSON users.
+func (i *UserImporter) ImportFrom(ctx context.Context, j *Job, r io.Reader) (*UserImportReport, error) {
+	br := bufio.NewReader(r)
+	dec := json.NewDecoder(br)
+	dec.UseNumber()
+	for {
+		c, err := br.Peek(1)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if c[0] == ' ' || c[0] == '\t' || c[0] == '\r' || c[0] == '\n' {
+			br.Discard(1)
+			continue
+		}
+		if c[0] == '[' {
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+		}
+		break
+	}
+
+	return i.Import(ctx, j, func() (map[string]interface{}, error) {
+		if !dec.More() {
+			return nil, Done
+		}
+		var u map[string]interface{}
+		if err := dec.Decode(&u); err != nil {
+			return nil, err
+		}
+		return u, nil
+	})
+}
+
+// Import imports the users returned by next until it returns Done, using the
+// connection and settings of j, whose Users are ignored.
+//
+// Users are read as jobs can be submitted, so that they do not need to be held
+// in memory at once. The returned error reports failing to read the users or
+// ctx being done, while the failures of the jobs are reported by the report.
+func (i *UserImporter) Import(ctx context.Context, j *Job, next func() (map[string]interface{}, error)) (*UserImportReport, error) {
+	chunkSize := i.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = 500 * 1000
+	}
+	maxJobs := i.MaxJobs
+	if maxJobs <= 0 {
+		maxJobs = 2
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		jobs   = make(chan struct{}, maxJobs)
+		report = &UserImportReport{}
+		chunk  = &userChunk{}
+		err    error
+	)
+	fail := func(failures ...*UserImportFailure) {
+		mu.Lock()
+		report.Failures = append(report.Failures, failures...)
+		mu.Unlock()
+	}
+	submit := func(c *userChunk) error {
+		select {
+		case jobs <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-jobs }()
+			job, failures := i.importChunk(ctx, j, c)
+			fail(failures...)
+			if job != nil {
+				mu.Lock()
+				report.Jobs = append(report.Jobs, job)
+				mu.Unlock()
+			}
+		}()
+		return nil
+	}
+
+	for ; ; report.Users++ {
+		var u map[string]interface{}
+		if u, err = next(); err != nil {
+			break
+		}
+		b, jsonErr := json.Marshal(u)
+		if jsonErr == nil && len(b)+2 > chunkSize {
+			jsonErr = fmt.Errorf("user exceeds the maximum size of %d bytes", chunkSize)
+		}
+		if jsonErr != nil {
+			fail(&UserImportFailure{Index: report.Users, User: u, Err: jsonErr})
+			continue
+		}
+		if len(chunk.users) > 0 && chunk.size()+len(b)+1 > chunkSize {
+			if err = submit(chunk); err != nil {
+				break
+			}
+			chunk = &userChunk{}
+		}
+		chunk.add(report.Users, u, b)
+	}
+	if err == Done {
+		err = nil
+		if len(chunk.users) > 0 {
+			err = submit(chunk)
+		}
+	}
+	wg.Wait()
+
+	sort.SliceStable(report.Failures, func(a, b int) bool {
+		return report.Failures[a].Index < report.Failures[b].Index
+	})
+	return report, err
+}
+
+// importChunk imports the users of the chunk through a job configured like j,
+// returning the job, if submitted, and the users which failed.
+func (i *UserImporter) importChunk(ctx context.Context, j *Job, c *userChunk) (*Job, []*UserImportFailure) {
+	job := &Job{
+		ConnectionID:        j.ConnectionID,
+		Upsert:              j.Upsert,
+		ExternalID:          j.ExternalID,
+		SendCompletionEmail: j.SendCompletionEmail,
+		Users:               c.users,
+	}
+	failAll := func(err error) []*UserImportFailure {
+		failures := make([]*UserImportFailure, len(c.users))
+		for n, u := range c.users {
+			failures[n] = &UserImportFailure{Index: c.index[n], User: u, Err: err}
+		}
+		return failures
+	}
+
+	if err := i.Job.ImportUsersContext(ctx, job); err != nil {
+		return nil, failAll(err)
+	}
+	job.Users = nil
+	if job.GetID() == "" {
+		// The job was planned in dry-run mode, rather than submitted.
+		return job, nil
+	}
+	if err := i.Job.WaitContext(ctx, job, nil); err != nil {
+		return job, failAll(err)
+	}
+	if job.Summary != nil && job.Summary.GetFailed() == 0 {
+		return job, nil
+	}
+	errs, err := i.Job.ErrorsContext(ctx, job.GetID())
+	if err != nil {
+		return job, failAll(fmt.Errorf("retrieving the errors of job %s failed: %w", job.GetID(), err))
+	}
+
+	var failures []*UserImportFailure
+	matched := make([]bool, len(c.users))
+	for _, e := range errs {
+		f := &UserImportFailure{Index: -1, User: e.User, Errors: e.Errors}
+		if n := c.match(e.User, matched); n >= 0 {
+			matched[n] = true
+			f.Index, f.User = c.index[n], c.users[n]
+		}
+		failures = append(failures, f)
+	}
+	return job, failures
+}
+
+// userChunk holds the users submitted by an import job.
+type userChunk struct {
+	index []int
+	users []map[string]interface{}
+	json  [][]byte
+	bytes int
+}
+
+// size returns the size of the chunk encoded as a JSON array.
+func (c *userChunk) size() int {
+	if len(c.users) == 0 {
+		return 2
+	}
+	return c.bytes + len(c.users) - 1 + 2
+}
+
+func (c *userChunk) add(index int, u map[string]interface{}, b []byte) {
+	c.index = append(c.index, index)
+	c.users = append(c.users, u)
+	c.json = append(c.json, b)
+	c.bytes += len(b)
+}
+
+// match returns the position of the user reported by a job error among the
+// users of the chunk not yet matched, comparing their user_id or email when
+// set, and every property otherwise. It returns -1 if none matches.
+func (c *userChunk) match(u map[string]interface{}, matched []bool) int {
+	b, _ := json.Marshal(u)
+	for _, key := range []string{"user_id", "email"} {
+		if _, ok := u[key]; !ok {
+			continue
+		}
+		for n, v := range c.users {
+			if !matched[n] && fmt.Sprint(v[key]) == fmt.Sprint(u[key]) {
+				return n
+			}
+		}
+	}
+	for n := range c.users {
+		if !matched[n] && bytes.Equal(c.json[n], b) {
+			return n
+		}
+	}
+	return -1
+}
@@ -0,0 +1,151 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+)
+
+func TestUserImporter(t *testing.T) {
+
+	defer func(delay time.Duration) { jobPollDelay = delay }(jobPollDelay)
+	jobPollDelay = time.Millisecond
+
+	var (
+		mu        sync.Mutex
+		jobs      = make(map[string][]map[string]interface{})
+		active    int
+		maxActive int
+		sizes     []int
+	)
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/api/v2/jobs/users-imports":
+			f, _, err := r.FormFile("users")
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			var users []map[string]interface{}
+			dec := json.NewDecoder(f)
+			if err := dec.Decode(&users); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			sizes = append(sizes, int(dec.InputOffset()))
+
+			id := fmt.Sprintf("job_%d", len(jobs))
+			jobs[id] = users
+			if active++; active > maxActive {
+				maxActive = active
+			}
+			if r.FormValue("connection_id") != "con_1" || r.FormValue("upsert") != "true" {
+				http.Error(w, "unexpected job settings", http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"` + id + `","status":"pending","type":"users_import"}`))
+
+		case strings.HasSuffix(r.URL.Path, "/errors"):
+			id := strings.Split(r.URL.Path, "/")[4]
+			var errs []*JobError
+			for _, u := range jobs[id] {
+				if u["email"] == "taken@example.com" {
+					errs = append(errs, &JobError{
+						User: map[string]interface{}{"email": u["email"]},
+						Errors: []*JobErrorDetails{{
+							Code:    auth0.String("DUPLICATED_USER"),
+							Message: auth0.String("The user already exist and upsert parameter is false"),
+						}},
+					})
+				}
+			}
+			if errs == nil {
+				w.Write([]byte(`{"id":"` + id + `","status":"completed"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(errs)
+
+		default:
+			id := strings.Split(r.URL.Path, "/")[4]
+			active--
+			w.Write([]byte(`{"id":"` + id + `","status":"completed","type":"users_import"}`))
+		}
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := New(s.URL, WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var input strings.Builder
+	input.WriteString("[\n")
+	for n := 0; n < 20; n++ {
+		email := fmt.Sprintf("user%d@example.com", n)
+		switch n {
+		case 7:
+			email = "taken@example.com"
+		case 12:
+			email = strings.Repeat("x", 300) + "@example.com"
+		}
+		if n > 0 {
+			input.WriteString(",\n")
+		}
+		fmt.Fprintf(&input, `  {"email": %q, "email_verified": true}`, email)
+	}
+	input.WriteString("\n]\n")
+
+	i := &UserImporter{Job: m.Job, ChunkSize: 200, MaxJobs: 2}
+	report, err := i.ImportFrom(context.Background(), &Job{
+		ConnectionID: auth0.String("con_1"),
+		Upsert:       auth0.Bool(true),
+	}, strings.NewReader(input.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect.Expect(t, report.Users, 20)
+	var imported int
+	for _, users := range jobs {
+		imported += len(users)
+	}
+	expect.Expect(t, imported, 19)
+	expect.Expect(t, len(report.Jobs), len(jobs))
+	for _, size := range sizes {
+		if size > 200 {
+			t.Errorf("Expected users files of at most 200 bytes, got %d", size)
+		}
+	}
+	if maxActive > 2 {
+		t.Errorf("Expected at most 2 concurrent jobs, got %d", maxActive)
+	}
+
+	if len(report.Failures) != 2 {
+		t.Fatalf("Expected 2 failures, got %d", len(report.Failures))
+	}
+	taken := report.Failures[0]
+	expect.Expect(t, taken.Index, 7)
+	expect.Expect(t, taken.User, map[string]interface{}{"email": "taken@example.com", "email_verified": true})
+	expect.Expect(t, taken.Errors[0].GetCode(), "DUPLICATED_USER")
+	expect.Expect(t, taken.Err, nil)
+
+	large := report.Failures[1]
+	expect.Expect(t, large.Index, 12)
+	if large.Err == nil {
+		t.Error("Expected an error importing a user larger than the chunk size")
+	}
+}
@@ -0,0 +1,140 @@
+package management
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gopkg.in/auth0.v5/management/search"
+)
+
+// userSearchLimit is the maximum number of users a search returns.
+const userSearchLimit = 1000
+
+// userSearch iterates over the users matching a search, splitting it into
+// searches of users created within time windows matching at most
+// userSearchLimit users each.
+type userSearch struct {
+	m    *UserManager
+	ctx  context.Context
+	opts []RequestOption
+	q    string
+
+	// The windows left to search, the next one last, and the one whose pages
+	// are being fetched, if any.
+	windows []userSearchWindow
+	current *userSearchWindow
+	page    int
+	seen    map[string]bool
+
+	users []interface{}
+	err   error
+}
+
+// userSearchWindow is a time window in which users were created, from the
+// from time, included, until the to time, excluded.
+type userSearchWindow struct {
+	from, to time.Time
+}
+
+func newUserSearch(m *UserManager, opts []RequestOption) *userSearch {
+	// Creation times are compared to the millisecond.
+	now := time.Now().Truncate(time.Millisecond).Add(time.Millisecond)
+	return &userSearch{
+		m:       m,
+		ctx:     requestContext(opts),
+		opts:    append([]RequestOption{PerPage(100), Parameter("sort", "created_at:1")}, opts...),
+		q:       requestQuery(opts, "q"),
+		windows: []userSearchWindow{{time.Unix(0, 0), now}},
+	}
+}
+
+func (s *userSearch) next() (interface{}, error) {
+	for len(s.users) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		s.fetchNext()
+	}
+	if err := s.ctx.Err(); err != nil {
+		s.err = err
+		return nil, err
+	}
+	v := s.users[0]
+	s.users = s.users[1:]
+	return v, nil
+}
+
+// fetchNext fetches the next page of the current window, or the first page of
+// the next window once it is known to match few enough users, splitting it in
+// two otherwise.
+func (s *userSearch) fetchNext() {
+	if err := s.ctx.Err(); err != nil {
+		s.err = err
+		return
+	}
+
+	if s.current != nil {
+		s.page++
+	} else {
+		if len(s.windows) == 0 {
+			s.err = Done
+			return
+		}
+		w := s.windows[len(s.windows)-1]
+		s.windows = s.windows[:len(s.windows)-1]
+
+		// Only the number of users matching is needed to tell whether the
+		// window must be split, so a single user is requested.
+		ul, err := s.list(w, PerPage(1))
+		if err != nil {
+			s.err = err
+			return
+		}
+		if ul.Total == 0 {
+			return
+		}
+		if ul.Total > userSearchLimit {
+			if w.to.Sub(w.from) < 2*time.Millisecond {
+				s.err = fmt.Errorf("more than %d users created at %s match the search", userSearchLimit, w.from.UTC().Format(time.RFC3339Nano))
+				return
+			}
+			mid := w.from.Add(w.to.Sub(w.from) / 2).Truncate(time.Millisecond)
+			s.windows = append(s.windows, userSearchWindow{mid, w.to}, userSearchWindow{w.from, mid})
+			return
+		}
+		s.current, s.page = &w, 0
+		s.seen = make(map[string]bool)
+	}
+
+	ul, err := s.list(*s.current, Page(s.page))
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.add(ul)
+}
+
+// list fetches the users created within the window.
+func (s *userSearch) list(w userSearchWindow, opts ...RequestOption) (*UserList, error) {
+	q := search.CreatedBetween(w.from, w.to).String()
+	if s.q != "" {
+		q = "(" + s.q + ") AND " + q
+	}
+	return s.m.List(append(append(s.opts, Query(q)), opts...)...)
+}
+
+// add adds the users of the page not seen yet in the current window, which
+// can be returned by several pages when users are created or deleted
+// meanwhile.
+func (s *userSearch) add(ul *UserList) {
+	for _, u := range ul.Users {
+		if !s.seen[u.GetID()] {
+			s.seen[u.GetID()] = true
+			s.users = append(s.users, u)
+		}
+	}
+	if len(ul.Users) == 0 || !ul.HasNext() {
+		s.current = nil
+	}
+}
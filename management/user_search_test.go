@@ -0,0 +1,102 @@
+package management
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/internal/testing/expect"
+	"gopkg.in/auth0.v5/management/search"
+)
+
+func TestUserSearchAll(t *testing.T) {
+
+	var users []*User
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3000; i++ {
+		users = append(users, &User{
+			ID:            auth0.String(fmt.Sprintf("auth0|%d", i)),
+			EmailVerified: auth0.Bool(i%3 != 0),
+			CreatedAt:     auth0.Time(start.Add(time.Duration(i) * time.Hour)),
+		})
+	}
+
+	createdAt := regexp.MustCompile(`^\(email_verified:true\) AND created_at:\["(.+)" TO "(.+)"\}$`)
+	var searches, served int
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searches++
+		q := r.URL.Query()
+		m := createdAt.FindStringSubmatch(q.Get("q"))
+		if m == nil || q.Get("search_engine") != "v3" || q.Get("sort") != "created_at:1" {
+			http.Error(w, "unexpected query: "+q.Get("q"), http.StatusBadRequest)
+			return
+		}
+		from, _ := time.Parse(time.RFC3339, m[1])
+		to, _ := time.Parse(time.RFC3339, m[2])
+		page, _ := strconv.Atoi(q.Get("page"))
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+
+		var matching []*User
+		for _, u := range users {
+			if u.GetEmailVerified() && !u.GetCreatedAt().Before(from) && u.GetCreatedAt().Before(to) {
+				matching = append(matching, u)
+			}
+		}
+		ul := &UserList{List: List{Start: page * perPage, Limit: perPage, Total: len(matching)}}
+		if page*perPage >= userSearchLimit {
+			http.Error(w, "page out of range", http.StatusBadRequest)
+			return
+		}
+		for i := page * perPage; i < len(matching) && i < (page+1)*perPage; i++ {
+			ul.Users = append(ul.Users, matching[i])
+		}
+		ul.Length = len(ul.Users)
+		if perPage > 1 {
+			served += ul.Length
+		}
+		json.NewEncoder(w).Encode(ul)
+	})
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	m, err := New(s.URL, WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[string]bool)
+	var last time.Time
+	it := m.User.SearchAll(Query(search.Term("email_verified", "true").String()))
+	for {
+		u, err := it.Next()
+		if err == Done {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[u.GetID()] {
+			t.Fatalf("Expected %s to be returned once", u.GetID())
+		}
+		if u.GetCreatedAt().Before(last) {
+			t.Fatalf("Expected users to be returned in creation order")
+		}
+		seen[u.GetID()] = true
+		last = u.GetCreatedAt()
+	}
+	expect.Expect(t, len(seen), 2000)
+	if searches > 50 {
+		t.Errorf("Expected at most 50 searches, sent %d", searches)
+	}
+	// Windows matching too many users are split without fetching their pages.
+	expect.Expect(t, served, 2000)
+	if !seen["auth0|1"] || seen["auth0|3"] {
+		t.Error("Expected only the users matching the query to be returned")
+	}
+}